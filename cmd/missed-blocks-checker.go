@@ -5,6 +5,7 @@ import (
 	configPkg "main/pkg/config"
 	"main/pkg/fs"
 	"main/pkg/logger"
+	"main/pkg/templates"
 
 	"github.com/spf13/cobra"
 )
@@ -31,6 +32,10 @@ func ExecuteValidateConfig(configPath string) {
 		logger.GetDefaultLogger().Panic().Err(err).Msg("Config is invalid!")
 	}
 
+	if err := templates.ValidateConfigOverrides(config); err != nil {
+		logger.GetDefaultLogger().Panic().Err(err).Msg("Templates are invalid!")
+	}
+
 	logger.GetDefaultLogger().Info().Msg("Provided config is valid.")
 }
 
@@ -0,0 +1,6 @@
+package locales
+
+import "embed"
+
+//go:embed *.toml
+var LocalesFs embed.FS
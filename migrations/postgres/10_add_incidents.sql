@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS incidents (
+    id SERIAL PRIMARY KEY,
+    chain TEXT NOT NULL,
+    operator_address TEXT NOT NULL,
+    started_at BIGINT NOT NULL,
+    acked_by TEXT NOT NULL DEFAULT '',
+    acked_at BIGINT NOT NULL DEFAULT 0,
+    resolved_at BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS incidents_chain_resolved ON incidents (chain, resolved_at);
+
+-- +goose Down
+DROP TABLE incidents;
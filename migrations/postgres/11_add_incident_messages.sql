@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS incident_messages (
+    chain TEXT NOT NULL,
+    reporter TEXT NOT NULL,
+    destination TEXT NOT NULL,
+    operator_address TEXT NOT NULL,
+    incident_id BIGINT NOT NULL,
+    message_id TEXT NOT NULL,
+    progression TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (chain, reporter, destination, operator_address)
+);
+
+-- +goose Down
+DROP TABLE incident_messages;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE incidents ADD COLUMN outcome TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE incidents DROP COLUMN outcome;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE notifiers ADD COLUMN delivery_mode TEXT NOT NULL DEFAULT 'channel';
+
+-- +goose Down
+ALTER TABLE notifiers DROP COLUMN delivery_mode;
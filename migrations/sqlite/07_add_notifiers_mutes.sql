@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE notifiers ADD COLUMN muted_until BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE notifiers ADD COLUMN quiet_hours_start TEXT NOT NULL DEFAULT '';
+ALTER TABLE notifiers ADD COLUMN quiet_hours_end TEXT NOT NULL DEFAULT '';
+ALTER TABLE notifiers ADD COLUMN quiet_hours_timezone TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE notifiers DROP COLUMN quiet_hours_timezone;
+ALTER TABLE notifiers DROP COLUMN quiet_hours_end;
+ALTER TABLE notifiers DROP COLUMN quiet_hours_start;
+ALTER TABLE notifiers DROP COLUMN muted_until;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE notifiers ADD COLUMN min_missed_percent REAL NOT NULL DEFAULT 0;
+ALTER TABLE notifiers ADD COLUMN events TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE notifiers DROP COLUMN events;
+ALTER TABLE notifiers DROP COLUMN min_missed_percent;
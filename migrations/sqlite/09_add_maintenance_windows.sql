@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS maintenance_windows (
+    chain TEXT NOT NULL,
+    operator_address TEXT NOT NULL,
+    start_time BIGINT NOT NULL DEFAULT 0,
+    end_time BIGINT NOT NULL DEFAULT 0,
+    start_height BIGINT NOT NULL DEFAULT 0,
+    end_height BIGINT NOT NULL DEFAULT 0,
+    created_by TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (chain, operator_address)
+);
+
+-- +goose Down
+DROP TABLE maintenance_windows;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE incident_messages ADD COLUMN thread_id TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE incident_messages DROP COLUMN thread_id;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE notifiers ADD COLUMN language TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE notifiers DROP COLUMN language;
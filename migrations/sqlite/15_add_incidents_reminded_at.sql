@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE incidents ADD COLUMN reminded_at BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE incidents DROP COLUMN reminded_at;
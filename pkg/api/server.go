@@ -0,0 +1,219 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	configPkg "main/pkg/config"
+	"main/pkg/types"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type MaintenanceManager interface {
+	GetValidator(operatorAddress string) (*types.Validator, bool)
+	GetMaintenanceWindows() []*types.MaintenanceWindow
+	SetMaintenanceWindow(window *types.MaintenanceWindow) error
+	RemoveMaintenanceWindow(operatorAddress string) bool
+}
+
+type MaintenanceWindowRequest struct {
+	Duration    string `json:"duration"`
+	Start       string `json:"start"`
+	StartHeight int64  `json:"start-height"`
+	EndHeight   int64  `json:"end-height"`
+}
+
+type MaintenanceWindowResponse struct {
+	Validator   string     `json:"validator"`
+	StartTime   *time.Time `json:"start-time,omitempty"`
+	EndTime     *time.Time `json:"end-time,omitempty"`
+	StartHeight int64      `json:"start-height,omitempty"`
+	EndHeight   int64      `json:"end-height,omitempty"`
+	CreatedBy   string     `json:"created-by"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type Server struct {
+	logger   zerolog.Logger
+	config   configPkg.APIConfig
+	managers map[string]MaintenanceManager
+}
+
+func NewServer(
+	logger zerolog.Logger,
+	config configPkg.APIConfig,
+	managers map[string]MaintenanceManager,
+) *Server {
+	return &Server{
+		logger:   logger.With().Str("component", "api_server").Logger(),
+		config:   config,
+		managers: managers,
+	}
+}
+
+func (s *Server) Start() {
+	if !s.config.Enabled() {
+		s.logger.Info().Msg("API not enabled")
+		return
+	}
+
+	server := &http.Server{
+		Addr:              s.config.ListenAddr,
+		Handler:           s.GetHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.logger.Info().Str("addr", s.config.ListenAddr).Msg("API handler listening")
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error().
+			Err(err).
+			Str("addr", s.config.ListenAddr).
+			Msg("Cannot start API handler")
+	}
+}
+
+func (s *Server) GetHandler() http.Handler {
+	handler := http.NewServeMux()
+	handler.HandleFunc("GET /api/chains/{chain}/maintenance", s.HandleListMaintenanceWindows)
+	handler.HandleFunc("PUT /api/chains/{chain}/maintenance/{validator}", s.HandleSetMaintenanceWindow)
+	handler.HandleFunc("DELETE /api/chains/{chain}/maintenance/{validator}", s.HandleRemoveMaintenanceWindow)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := "Bearer " + s.config.Token
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) != 1 {
+			s.logger.Warn().
+				Str("method", r.Method).
+				Str("path", r.URL.Path).
+				Msg("Got unauthorized API request")
+			s.Respond(w, http.StatusUnauthorized, ErrorResponse{Error: "unauthorized"})
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) GetManager(w http.ResponseWriter, r *http.Request) (MaintenanceManager, bool) {
+	manager, found := s.managers[r.PathValue("chain")]
+	if !found {
+		s.Respond(w, http.StatusNotFound, ErrorResponse{
+			Error: fmt.Sprintf("chain %s is not found", r.PathValue("chain")),
+		})
+	}
+
+	return manager, found
+}
+
+func (s *Server) HandleListMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	manager, found := s.GetManager(w, r)
+	if !found {
+		return
+	}
+
+	windows := manager.GetMaintenanceWindows()
+	response := make([]MaintenanceWindowResponse, len(windows))
+
+	for index, window := range windows {
+		response[index] = NewMaintenanceWindowResponse(window)
+	}
+
+	s.Respond(w, http.StatusOK, response)
+}
+
+func (s *Server) HandleSetMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	manager, found := s.GetManager(w, r)
+	if !found {
+		return
+	}
+
+	address := r.PathValue("validator")
+	if _, found := manager.GetValidator(address); !found {
+		s.Respond(w, http.StatusNotFound, ErrorResponse{Error: fmt.Sprintf("validator %s is not found", address)})
+		return
+	}
+
+	var request MaintenanceWindowRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		s.Respond(w, http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("invalid request: %s", err)})
+		return
+	}
+
+	window, err := types.ParseMaintenanceWindow(address, request.GetArgs(), time.Now())
+	if err != nil {
+		s.Respond(w, http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	window.CreatedBy = "api"
+
+	if err := manager.SetMaintenanceWindow(window); err != nil {
+		s.Respond(w, http.StatusInternalServerError, ErrorResponse{Error: "could not save maintenance window"})
+		return
+	}
+
+	s.Respond(w, http.StatusOK, NewMaintenanceWindowResponse(window))
+}
+
+func (s *Server) HandleRemoveMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	manager, found := s.GetManager(w, r)
+	if !found {
+		return
+	}
+
+	address := r.PathValue("validator")
+	if !manager.RemoveMaintenanceWindow(address) {
+		s.Respond(w, http.StatusNotFound, ErrorResponse{
+			Error: fmt.Sprintf("validator %s has no maintenance window", address),
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) Respond(w http.ResponseWriter, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Error().Err(err).Msg("Could not write API response")
+	}
+}
+
+// GetArgs converts the request to the arguments accepted by types.ParseMaintenanceWindow.
+func (r MaintenanceWindowRequest) GetArgs() []string {
+	if r.EndHeight > 0 {
+		return []string{strconv.FormatInt(r.StartHeight, 10) + "-" + strconv.FormatInt(r.EndHeight, 10)}
+	}
+
+	if r.Start != "" {
+		return []string{r.Duration, r.Start}
+	}
+
+	return []string{r.Duration}
+}
+
+func NewMaintenanceWindowResponse(window *types.MaintenanceWindow) MaintenanceWindowResponse {
+	response := MaintenanceWindowResponse{
+		Validator:   window.OperatorAddress,
+		StartHeight: window.StartHeight,
+		EndHeight:   window.EndHeight,
+		CreatedBy:   window.CreatedBy,
+	}
+
+	if !window.IsHeightBased() {
+		response.StartTime = &window.StartTime
+		response.EndTime = &window.EndTime
+	}
+
+	return response
+}
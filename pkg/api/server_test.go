@@ -0,0 +1,154 @@
+package api
+
+import (
+	"encoding/json"
+	configPkg "main/pkg/config"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testManager struct {
+	windows map[string]*types.MaintenanceWindow
+}
+
+func (m *testManager) GetValidator(operatorAddress string) (*types.Validator, bool) {
+	if operatorAddress != "validator" {
+		return nil, false
+	}
+
+	return &types.Validator{OperatorAddress: operatorAddress}, true
+}
+
+func (m *testManager) GetMaintenanceWindows() []*types.MaintenanceWindow {
+	windows := make([]*types.MaintenanceWindow, 0)
+	for _, window := range m.windows {
+		windows = append(windows, window)
+	}
+
+	return windows
+}
+
+func (m *testManager) SetMaintenanceWindow(window *types.MaintenanceWindow) error {
+	m.windows[window.OperatorAddress] = window
+	return nil
+}
+
+func (m *testManager) RemoveMaintenanceWindow(operatorAddress string) bool {
+	if _, found := m.windows[operatorAddress]; !found {
+		return false
+	}
+
+	delete(m.windows, operatorAddress)
+	return true
+}
+
+func getTestServer() (http.Handler, *testManager) {
+	manager := &testManager{windows: make(map[string]*types.MaintenanceWindow)}
+	server := NewServer(
+		*loggerPkg.GetNopLogger(),
+		configPkg.APIConfig{ListenAddr: ":9580", Token: "token"},
+		map[string]MaintenanceManager{"chain": manager},
+	)
+
+	return server.GetHandler(), manager
+}
+
+func doRequest(handler http.Handler, method string, path string, body string, token string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := getTestServer()
+
+	response := doRequest(handler, http.MethodGet, "/api/chains/chain/maintenance", "", "")
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	response = doRequest(handler, http.MethodGet, "/api/chains/chain/maintenance", "", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
+
+func TestServerUnknownChain(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := getTestServer()
+
+	response := doRequest(handler, http.MethodGet, "/api/chains/unknown/maintenance", "", "token")
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestServerSetMaintenanceWindowInvalid(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := getTestServer()
+
+	response := doRequest(handler, http.MethodPut, "/api/chains/chain/maintenance/unknown", `{"duration":"2h"}`, "token")
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	response = doRequest(handler, http.MethodPut, "/api/chains/chain/maintenance/validator", `invalid`, "token")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = doRequest(handler, http.MethodPut, "/api/chains/chain/maintenance/validator", `{"duration":"invalid"}`, "token")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestServerMaintenanceWindowLifecycle(t *testing.T) {
+	t.Parallel()
+
+	handler, manager := getTestServer()
+
+	response := doRequest(
+		handler,
+		http.MethodPut,
+		"/api/chains/chain/maintenance/validator",
+		`{"start-height":100,"end-height":200}`,
+		"token",
+	)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Contains(t, manager.windows, "validator")
+	assert.Equal(t, int64(200), manager.windows["validator"].EndHeight)
+	assert.Equal(t, "api", manager.windows["validator"].CreatedBy)
+
+	response = doRequest(handler, http.MethodGet, "/api/chains/chain/maintenance", "", "token")
+	require.Equal(t, http.StatusOK, response.Code)
+
+	var windows []MaintenanceWindowResponse
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &windows))
+	require.Len(t, windows, 1)
+	assert.Equal(t, "validator", windows[0].Validator)
+	assert.Nil(t, windows[0].StartTime)
+
+	response = doRequest(handler, http.MethodDelete, "/api/chains/chain/maintenance/validator", "", "token")
+	assert.Equal(t, http.StatusNoContent, response.Code)
+	assert.Empty(t, manager.windows)
+
+	response = doRequest(handler, http.MethodDelete, "/api/chains/chain/maintenance/validator", "", "token")
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestMaintenanceWindowRequestGetArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"100-200"}, MaintenanceWindowRequest{StartHeight: 100, EndHeight: 200}.GetArgs())
+	assert.Equal(t, []string{"2h"}, MaintenanceWindowRequest{Duration: "2h"}.GetArgs())
+	assert.Equal(
+		t,
+		[]string{"2h", "2024-01-01T10:00:00Z"},
+		MaintenanceWindowRequest{Duration: "2h", Start: "2024-01-01T10:00:00Z"}.GetArgs(),
+	)
+}
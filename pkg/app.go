@@ -1,11 +1,15 @@
 package pkg
 
 import (
+	"main/pkg/api"
 	configPkg "main/pkg/config"
 	databasePkg "main/pkg/database"
 	"main/pkg/fs"
 	loggerPkg "main/pkg/logger"
 	"main/pkg/metrics"
+	"main/pkg/reporters/discord"
+	"main/pkg/reporters/telegram"
+	"main/pkg/templates"
 
 	"github.com/rs/zerolog"
 )
@@ -15,6 +19,8 @@ type App struct {
 	Config         *configPkg.Config
 	Database       *databasePkg.Database
 	MetricsManager *metrics.Manager
+	APIServer      *api.Server
+	WebhookServer  *telegram.WebhookServer
 	Version        string
 
 	AppManagers []*AppManager
@@ -30,6 +36,10 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Provided config is invalid!")
 	}
 
+	if err = templates.ValidateConfigOverrides(config); err != nil {
+		loggerPkg.GetDefaultLogger().Panic().Err(err).Msg("Provided templates are invalid!")
+	}
+
 	for _, chainConfig := range config.ChainConfigs {
 		chainConfig.RecalculateMissedBlocksGroups()
 	}
@@ -42,7 +52,32 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 	metricsManager := metrics.NewManager(logger, config.MetricsConfig)
 	database := databasePkg.NewDatabase(logger, config.DatabaseConfig)
 
+	webhookServer := telegram.NewWebhookServer(logger, config.TelegramWebhookConfig)
+
+	telegramBots := make(map[string]*telegram.Bot, len(config.TelegramBots))
+	for _, botConfig := range config.TelegramBots {
+		telegramBots[botConfig.Name] = telegram.NewBot(
+			botConfig.Token,
+			botConfig.Admins,
+			webhookServer,
+			version,
+			logger,
+		)
+	}
+
+	discordBots := make(map[string]*discord.Bot, len(config.DiscordBots))
+	for _, botConfig := range config.DiscordBots {
+		discordBots[botConfig.Name] = discord.NewBot(
+			botConfig.Token,
+			botConfig.Guild,
+			botConfig.DiscordPermissions,
+			version,
+			logger,
+		)
+	}
+
 	appManagers := make([]*AppManager, len(config.ChainConfigs))
+	maintenanceManagers := make(map[string]api.MaintenanceManager, len(config.ChainConfigs))
 	for index, chainConfig := range config.ChainConfigs {
 		appManagers[index] = NewAppManager(
 			logger,
@@ -50,7 +85,11 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 			version,
 			metricsManager,
 			database,
+			telegramBots[chainConfig.TelegramConfig.Bot],
+			discordBots[chainConfig.DiscordConfig.Bot],
+			webhookServer,
 		)
+		maintenanceManagers[chainConfig.Name] = appManagers[index].StateManager
 	}
 
 	return &App{
@@ -58,6 +97,8 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 		Config:         config,
 		Database:       database,
 		MetricsManager: metricsManager,
+		APIServer:      api.NewServer(logger, config.APIConfig, maintenanceManagers),
+		WebhookServer:  webhookServer,
 		Version:        version,
 		AppManagers:    appManagers,
 	}
@@ -66,6 +107,8 @@ func NewApp(configPath string, filesystem fs.FS, version string) *App {
 func (a *App) Start() {
 	a.Database.Init()
 	go a.MetricsManager.Start()
+	go a.APIServer.Start()
+	go a.WebhookServer.Start()
 
 	for _, chainConfig := range a.Config.ChainConfigs {
 		a.MetricsManager.SetDefaultMetrics(chainConfig)
@@ -6,11 +6,19 @@ import (
 	"main/pkg/constants"
 	dataPkg "main/pkg/data"
 	databasePkg "main/pkg/database"
+	"main/pkg/digest"
 	"main/pkg/metrics"
+	"main/pkg/outbox"
 	populatorsPkg "main/pkg/populators"
 	reportersPkg "main/pkg/reporters"
+	"main/pkg/reporters/alertmanager"
 	"main/pkg/reporters/discord"
+	"main/pkg/reporters/email"
+	"main/pkg/reporters/matrix"
+	"main/pkg/reporters/pagerduty"
+	"main/pkg/reporters/slack"
 	"main/pkg/reporters/telegram"
+	"main/pkg/reporters/webhook"
 	snapshotPkg "main/pkg/snapshot"
 	statePkg "main/pkg/state"
 	"main/pkg/tendermint"
@@ -31,6 +39,7 @@ type AppManager struct {
 	SnapshotManager    *snapshotPkg.Manager
 	WebsocketManager   *tendermint.WebsocketManager
 	MetricsManager     *metrics.Manager
+	OutboxManager      *outbox.Manager
 	Populators         map[constants.PopulatorType]*populatorsPkg.Wrapper
 	Reporters          []reportersPkg.Reporter
 	IsPopulatingBlocks bool
@@ -45,6 +54,9 @@ func NewAppManager(
 	version string,
 	metricsManager *metrics.Manager,
 	database *databasePkg.Database,
+	telegramBot *telegram.Bot,
+	discordBot *discord.Bot,
+	telegramWebhook *telegram.WebhookServer,
 ) *AppManager {
 	managerLogger := logger.
 		With().
@@ -57,10 +69,19 @@ func NewAppManager(
 	websocketManager := tendermint.NewWebsocketManager(managerLogger, config, metricsManager)
 
 	reporters := []reportersPkg.Reporter{
-		telegram.NewReporter(config, version, managerLogger, stateManager, metricsManager, snapshotManager),
-		discord.NewReporter(config, version, managerLogger, stateManager, metricsManager, snapshotManager),
+		telegram.NewReporter(config, version, managerLogger, stateManager, metricsManager, snapshotManager, telegramBot, telegramWebhook),
+		discord.NewReporter(config, version, managerLogger, stateManager, metricsManager, snapshotManager, discordBot),
+		slack.NewReporter(config, version, managerLogger, stateManager, metricsManager, snapshotManager),
+		matrix.NewReporter(config, version, managerLogger, stateManager, metricsManager, snapshotManager),
+		email.NewReporter(config, managerLogger, stateManager, metricsManager),
+		webhook.NewReporter(config, managerLogger, stateManager, metricsManager),
+		pagerduty.NewReporter(config, managerLogger, stateManager, metricsManager),
+		alertmanager.NewReporter(config, managerLogger, stateManager, metricsManager),
 	}
 
+	digestManager := digest.NewManager(managerLogger, config)
+	outboxManager := outbox.NewManager(managerLogger, config, database, metricsManager, digestManager, reporters)
+
 	populators := map[constants.PopulatorType]*populatorsPkg.Wrapper{
 		constants.PopulatorSlashingParams: populatorsPkg.NewWrapper(
 			populatorsPkg.NewSlashingParamsPopulator(config, dataManager, stateManager, metricsManager, managerLogger),
@@ -72,6 +93,11 @@ func NewAppManager(
 			config.Intervals.Trim*time.Second,
 			managerLogger,
 		),
+		constants.PopulatorOutbox: populatorsPkg.NewWrapper(
+			populatorsPkg.NewOutboxPopulator(outboxManager),
+			config.OutboxConfig.Interval*time.Second,
+			managerLogger,
+		),
 	}
 
 	return &AppManager{
@@ -83,6 +109,7 @@ func NewAppManager(
 		SnapshotManager:    snapshotManager,
 		WebsocketManager:   websocketManager,
 		MetricsManager:     metricsManager,
+		OutboxManager:      outboxManager,
 		Reporters:          reporters,
 		Populators:         populators,
 		IsPopulatingBlocks: false,
@@ -91,6 +118,7 @@ func NewAppManager(
 
 func (a *AppManager) Start() {
 	a.StateManager.Init()
+	a.OutboxManager.Init()
 
 	a.MetricsManager.LogSlashingParams(
 		a.Config.Name,
@@ -222,6 +250,8 @@ func (a *AppManager) ProcessSnapshot(block *types.Block) {
 		return
 	}
 
+	defer a.ReportSnapshot(block.Height, snapshot)
+
 	for _, entry := range snapshot.Entries {
 		a.Logger.Trace().
 			Str("valoper", entry.Validator.OperatorAddress).
@@ -268,6 +298,11 @@ func (a *AppManager) ProcessSnapshot(block *types.Block) {
 		return
 	}
 
+	report = a.StateManager.ApplyMaintenanceWindows(report, time.Now())
+
+	a.StateManager.UpdateIncidents(report, time.Now())
+	report.Events = append(report.Events, a.StateManager.GetIncidentReminders(report.Height, time.Now())...)
+
 	if report.Empty() {
 		a.Logger.Info().Msg("Report is empty, no events to send")
 		return
@@ -285,14 +320,29 @@ func (a *AppManager) ProcessSnapshot(block *types.Block) {
 			Msg("Error saving report to database")
 	}
 
+	a.OutboxManager.Enqueue(report)
+
+	if err := a.OutboxManager.Flush(); err != nil {
+		a.Logger.Error().
+			Err(err).
+			Msg("Error delivering reports from outbox")
+	}
+}
+
+// ReportSnapshot passes the snapshot to the reporters that need the validators state on each
+// snapshot. It is called after the report is sent, so they see the events from it first.
+func (a *AppManager) ReportSnapshot(height int64, snapshot snapshotPkg.Snapshot) {
 	for _, reporter := range a.Reporters {
-		if reporter.Enabled() {
-			if err := reporter.Send(report); err != nil {
-				a.Logger.Error().
-					Err(err).
-					Str("name", string(reporter.Name())).
-					Msg("Error sending report")
-			}
+		snapshotReporter, ok := reporter.(reportersPkg.SnapshotReporter)
+		if !ok || !reporter.Enabled() {
+			continue
+		}
+
+		if err := snapshotReporter.ReportSnapshot(height, snapshot); err != nil {
+			a.Logger.Error().
+				Err(err).
+				Str("name", string(reporter.Name())).
+				Msg("Error reporting snapshot")
 		}
 	}
 }
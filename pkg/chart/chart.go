@@ -0,0 +1,195 @@
+package chart
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"main/pkg/constants"
+	"main/pkg/types"
+)
+
+const (
+	Width  = 800
+	Height = 320
+
+	margin    = 10
+	barHeight = 80
+	gap       = 20
+)
+
+var (
+	colorBackground = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	colorFrame      = color.RGBA{R: 200, G: 200, B: 200, A: 255}
+	colorSigned     = color.RGBA{R: 76, G: 175, B: 80, A: 255}
+	colorMissed     = color.RGBA{R: 229, G: 57, B: 53, A: 255}
+	colorNotActive  = color.RGBA{R: 158, G: 158, B: 158, A: 255}
+	colorUnknown    = color.RGBA{R: 238, G: 238, B: 238, A: 255}
+	colorMissedArea = color.RGBA{R: 255, G: 205, B: 210, A: 255}
+	colorJail       = color.RGBA{R: 136, G: 14, B: 79, A: 255}
+)
+
+// BlocksChart draws the validator's blocks over the stored window: the top strip shows
+// the share of signed, missed and not active blocks, and the bottom one how the missed blocks
+// add up over the window, with a dashed line at the count the validator gets jailed at.
+type BlocksChart struct {
+	Signatures         []types.BlockSignature
+	MissedBlocksToJail int64
+}
+
+func NewBlocksChart(signatures []types.BlockSignature, missedBlocksToJail int64) *BlocksChart {
+	return &BlocksChart{
+		Signatures:         signatures,
+		MissedBlocksToJail: missedBlocksToJail,
+	}
+}
+
+func (c *BlocksChart) Count(status constants.BlockStatus) int64 {
+	var count int64
+
+	for _, signature := range c.Signatures {
+		if signature.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (c *BlocksChart) Render() ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: colorBackground}, image.Point{}, draw.Src)
+
+	bar := image.Rect(margin, margin, Width-margin, margin+barHeight)
+	area := image.Rect(margin, bar.Max.Y+gap, Width-margin, Height-margin)
+
+	if len(c.Signatures) > 0 {
+		c.drawBar(img, bar)
+		c.drawMissedArea(img, area)
+	}
+
+	drawFrame(img, bar)
+	drawFrame(img, area)
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// getColumnBlocks returns the range of blocks the column is drawn from,
+// so each column shows several blocks on large windows, or repeats a block on small ones.
+func (c *BlocksChart) getColumnBlocks(column, columns int) (int, int) {
+	from := len(c.Signatures) * column / columns
+	to := len(c.Signatures) * (column + 1) / columns
+	if to <= from {
+		to = from + 1
+	}
+
+	return from, to
+}
+
+func (c *BlocksChart) drawBar(img *image.RGBA, rect image.Rectangle) {
+	columns := rect.Dx()
+
+	for column := 0; column < columns; column++ {
+		from, to := c.getColumnBlocks(column, columns)
+		counts := make(map[constants.BlockStatus]int, 4)
+		for _, signature := range c.Signatures[from:to] {
+			counts[signature.Status]++
+		}
+
+		// missed blocks are drawn at the bottom and take at least a pixel,
+		// so they stand out even if there are few of them
+		total, drawn := to-from, 0
+		y := rect.Max.Y
+		for _, status := range []constants.BlockStatus{
+			constants.BlockStatusMissed,
+			constants.BlockStatusNotActive,
+			constants.BlockStatusUnknown,
+			constants.BlockStatusSigned,
+		} {
+			if counts[status] == 0 {
+				continue
+			}
+
+			drawn += counts[status]
+			nextY := min(rect.Max.Y-drawn*rect.Dy()/total, y-1)
+			if nextY < rect.Min.Y {
+				break
+			}
+
+			fillRect(img, image.Rect(rect.Min.X+column, nextY, rect.Min.X+column+1, y), getStatusColor(status))
+			y = nextY
+		}
+	}
+}
+
+func (c *BlocksChart) drawMissedArea(img *image.RGBA, rect image.Rectangle) {
+	missed := make([]int64, len(c.Signatures)+1)
+	for index, signature := range c.Signatures {
+		missed[index+1] = missed[index]
+		if signature.Status == constants.BlockStatusMissed {
+			missed[index+1]++
+		}
+	}
+
+	maxValue := missed[len(c.Signatures)]
+	if c.MissedBlocksToJail > maxValue {
+		maxValue = c.MissedBlocksToJail
+	}
+	maxValue += maxValue/10 + 1
+
+	getY := func(value int64) int {
+		return rect.Max.Y - int(value*int64(rect.Dy())/maxValue)
+	}
+
+	columns := rect.Dx()
+	previousY := getY(0)
+
+	for column := 0; column < columns; column++ {
+		_, to := c.getColumnBlocks(column, columns)
+		y := getY(missed[to])
+		x := rect.Min.X + column
+
+		fillRect(img, image.Rect(x, y, x+1, rect.Max.Y), colorMissedArea)
+		fillRect(img, image.Rect(x, min(y, previousY)-1, x+1, max(y, previousY)+1), colorMissed)
+		previousY = y
+	}
+
+	if c.MissedBlocksToJail <= 0 {
+		return
+	}
+
+	jailY := getY(c.MissedBlocksToJail)
+	for x := rect.Min.X; x < rect.Max.X; x += 12 {
+		fillRect(img, image.Rect(x, jailY-1, min(x+8, rect.Max.X), jailY+1), colorJail)
+	}
+}
+
+func getStatusColor(status constants.BlockStatus) color.Color {
+	switch status {
+	case constants.BlockStatusSigned:
+		return colorSigned
+	case constants.BlockStatusMissed:
+		return colorMissed
+	case constants.BlockStatusNotActive:
+		return colorNotActive
+	default:
+		return colorUnknown
+	}
+}
+
+func fillRect(img *image.RGBA, rect image.Rectangle, c color.Color) {
+	draw.Draw(img, rect, &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+func drawFrame(img *image.RGBA, rect image.Rectangle) {
+	fillRect(img, image.Rect(rect.Min.X-1, rect.Min.Y-1, rect.Max.X+1, rect.Min.Y), colorFrame)
+	fillRect(img, image.Rect(rect.Min.X-1, rect.Max.Y, rect.Max.X+1, rect.Max.Y+1), colorFrame)
+	fillRect(img, image.Rect(rect.Min.X-1, rect.Min.Y, rect.Min.X, rect.Max.Y), colorFrame)
+	fillRect(img, image.Rect(rect.Max.X, rect.Min.Y, rect.Max.X+1, rect.Max.Y), colorFrame)
+}
@@ -0,0 +1,102 @@
+package chart
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getSignatures(statuses ...constants.BlockStatus) []types.BlockSignature {
+	signatures := make([]types.BlockSignature, len(statuses))
+	for index, status := range statuses {
+		signatures[index] = types.BlockSignature{Height: int64(index + 1), Status: status}
+	}
+
+	return signatures
+}
+
+func renderChart(t *testing.T, chart *BlocksChart) image.Image {
+	t.Helper()
+
+	rendered, err := chart.Render()
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(rendered))
+	require.NoError(t, err)
+
+	return img
+}
+
+func TestBlocksChartCount(t *testing.T) {
+	t.Parallel()
+
+	chart := NewBlocksChart(getSignatures(
+		constants.BlockStatusSigned,
+		constants.BlockStatusMissed,
+		constants.BlockStatusSigned,
+		constants.BlockStatusNotActive,
+	), 10)
+
+	assert.Equal(t, int64(2), chart.Count(constants.BlockStatusSigned))
+	assert.Equal(t, int64(1), chart.Count(constants.BlockStatusMissed))
+	assert.Equal(t, int64(1), chart.Count(constants.BlockStatusNotActive))
+	assert.Equal(t, int64(0), chart.Count(constants.BlockStatusUnknown))
+}
+
+func TestBlocksChartRenderEmpty(t *testing.T) {
+	t.Parallel()
+
+	img := renderChart(t, NewBlocksChart(nil, 10))
+	assert.Equal(t, image.Rect(0, 0, Width, Height), img.Bounds())
+}
+
+func TestBlocksChartRenderBar(t *testing.T) {
+	t.Parallel()
+
+	img := renderChart(t, NewBlocksChart(getSignatures(
+		constants.BlockStatusMissed,
+		constants.BlockStatusNotActive,
+		constants.BlockStatusSigned,
+		constants.BlockStatusUnknown,
+	), 10))
+
+	y := margin + barHeight/2
+	columnWidth := (Width - 2*margin) / 4
+
+	assert.Equal(t, colorMissed, img.At(margin+columnWidth/2, y))
+	assert.Equal(t, colorNotActive, img.At(margin+columnWidth+columnWidth/2, y))
+	assert.Equal(t, colorSigned, img.At(margin+2*columnWidth+columnWidth/2, y))
+	assert.Equal(t, colorUnknown, img.At(margin+3*columnWidth+columnWidth/2, y))
+}
+
+func TestBlocksChartRenderMissedBlocksAndJailThreshold(t *testing.T) {
+	t.Parallel()
+
+	statuses := make([]constants.BlockStatus, 100)
+	for index := range statuses {
+		statuses[index] = constants.BlockStatusSigned
+		if index >= 50 {
+			statuses[index] = constants.BlockStatusMissed
+		}
+	}
+
+	chart := NewBlocksChart(getSignatures(statuses...), 100)
+	img := renderChart(t, chart)
+
+	areaTop := margin + barHeight + gap
+	areaBottom := Height - margin
+
+	// nothing is missed in the first half, so the area is empty there
+	assert.Equal(t, colorBackground, img.At(margin+100, areaBottom-10))
+	assert.Equal(t, colorMissedArea, img.At(Width-margin-2, areaBottom-10))
+
+	jailY := areaBottom - int(100*int64(areaBottom-areaTop)/111)
+	assert.Equal(t, colorJail, img.At(margin+1, jailY))
+	assert.Equal(t, colorBackground, img.At(margin+10, jailY))
+}
@@ -0,0 +1,6 @@
+package config
+
+type AlertmanagerConfig struct {
+	URLs   []string          `toml:"urls"`
+	Labels map[string]string `toml:"labels"`
+}
@@ -0,0 +1,20 @@
+package config
+
+import "errors"
+
+type APIConfig struct {
+	ListenAddr string `toml:"listen-addr"`
+	Token      string `toml:"token"`
+}
+
+func (c *APIConfig) Enabled() bool {
+	return c.ListenAddr != ""
+}
+
+func (c *APIConfig) Validate() error {
+	if c.Enabled() && c.Token == "" {
+		return errors.New("token is required when the API is enabled")
+	}
+
+	return nil
+}
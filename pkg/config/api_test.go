@@ -0,0 +1,15 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&APIConfig{}).Validate())
+	require.Error(t, (&APIConfig{ListenAddr: ":9580"}).Validate())
+	require.NoError(t, (&APIConfig{ListenAddr: ":9580", Token: "token"}).Validate())
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+type TelegramBotConfig struct {
+	Name   string  `toml:"name"`
+	Token  string  `toml:"token"`
+	Admins []int64 `toml:"admins"`
+}
+
+func (c *TelegramBotConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("bot name is not provided")
+	}
+
+	if c.Token == "" {
+		return errors.New("bot token is not provided")
+	}
+
+	return nil
+}
+
+type DiscordBotConfig struct {
+	Name  string `toml:"name"`
+	Token string `toml:"token"`
+	Guild string `toml:"guild"`
+	DiscordPermissions
+}
+
+func (c *DiscordBotConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("bot name is not provided")
+	}
+
+	if c.Token == "" {
+		return errors.New("bot token is not provided")
+	}
+
+	if c.Guild == "" {
+		return errors.New("bot guild is not provided")
+	}
+
+	if err := c.DiscordPermissions.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (config *Config) ValidateBots() error {
+	telegramBots := make(map[string]bool, len(config.TelegramBots))
+	for index, bot := range config.TelegramBots {
+		if err := bot.Validate(); err != nil {
+			return fmt.Errorf("error in telegram bot #%d: %s", index, err)
+		}
+
+		if telegramBots[bot.Name] {
+			return fmt.Errorf("duplicate telegram bot name: %s", bot.Name)
+		}
+
+		telegramBots[bot.Name] = true
+	}
+
+	discordBots := make(map[string]bool, len(config.DiscordBots))
+	for index, bot := range config.DiscordBots {
+		if err := bot.Validate(); err != nil {
+			return fmt.Errorf("error in discord bot #%d: %s", index, err)
+		}
+
+		if discordBots[bot.Name] {
+			return fmt.Errorf("duplicate discord bot name: %s", bot.Name)
+		}
+
+		discordBots[bot.Name] = true
+	}
+
+	for _, chainConfig := range config.ChainConfigs {
+		if chainConfig.TelegramConfig.Bot != "" {
+			if chainConfig.TelegramConfig.Token != "" {
+				return fmt.Errorf("chain %s has both telegram bot and token specified", chainConfig.Name)
+			}
+
+			if len(chainConfig.TelegramConfig.Admins) > 0 {
+				return fmt.Errorf(
+					"chain %s has telegram admins specified, they should be set in the telegram bot config instead",
+					chainConfig.Name,
+				)
+			}
+
+			if !telegramBots[chainConfig.TelegramConfig.Bot] {
+				return fmt.Errorf(
+					"chain %s references telegram bot %s which is not defined",
+					chainConfig.Name,
+					chainConfig.TelegramConfig.Bot,
+				)
+			}
+		}
+
+		if chainConfig.DiscordConfig.Bot != "" {
+			if chainConfig.DiscordConfig.Token != "" {
+				return fmt.Errorf("chain %s has both discord bot and token specified", chainConfig.Name)
+			}
+
+			if chainConfig.DiscordConfig.IsRestricted() {
+				return fmt.Errorf(
+					"chain %s has discord permissions specified, they should be set in the discord bot config instead",
+					chainConfig.Name,
+				)
+			}
+
+			if !discordBots[chainConfig.DiscordConfig.Bot] {
+				return fmt.Errorf(
+					"chain %s references discord bot %s which is not defined",
+					chainConfig.Name,
+					chainConfig.DiscordConfig.Bot,
+				)
+			}
+		}
+	}
+
+	return nil
+}
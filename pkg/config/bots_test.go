@@ -0,0 +1,145 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramBotConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, (&TelegramBotConfig{Token: "token"}).Validate())
+	require.Error(t, (&TelegramBotConfig{Name: "main"}).Validate())
+	require.NoError(t, (&TelegramBotConfig{Name: "main", Token: "token"}).Validate())
+}
+
+func TestDiscordBotConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, (&DiscordBotConfig{Token: "token", Guild: "guild"}).Validate())
+	require.Error(t, (&DiscordBotConfig{Name: "main", Guild: "guild"}).Validate())
+	require.Error(t, (&DiscordBotConfig{Name: "main", Token: "token"}).Validate())
+	require.NoError(t, (&DiscordBotConfig{Name: "main", Token: "token", Guild: "guild"}).Validate())
+}
+
+func TestDiscordBotConfigValidateInvalidPermissions(t *testing.T) {
+	t.Parallel()
+
+	config := &DiscordBotConfig{
+		Name:  "main",
+		Token: "token",
+		Guild: "guild",
+		DiscordPermissions: DiscordPermissions{
+			Permissions: map[string]DiscordCommandPermissions{"unknown": {AllowedUsers: []string{"owner"}}},
+		},
+	}
+	require.Error(t, config.Validate())
+}
+
+func TestValidateBotsInvalidBot(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{TelegramBots: []*TelegramBotConfig{{Name: "main"}}}
+	require.Error(t, config.ValidateBots())
+
+	config = &Config{DiscordBots: []*DiscordBotConfig{{Name: "main"}}}
+	require.Error(t, config.ValidateBots())
+}
+
+func TestValidateBotsDuplicateNames(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{TelegramBots: []*TelegramBotConfig{
+		{Name: "main", Token: "token1"},
+		{Name: "main", Token: "token2"},
+	}}
+	require.Error(t, config.ValidateBots())
+
+	config = &Config{DiscordBots: []*DiscordBotConfig{
+		{Name: "main", Token: "token1", Guild: "guild"},
+		{Name: "main", Token: "token2", Guild: "guild"},
+	}}
+	require.Error(t, config.ValidateBots())
+}
+
+func TestValidateBotsUnknownBot(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{ChainConfigs: []*ChainConfig{
+		{Name: "chain", TelegramConfig: TelegramConfig{Bot: "main"}},
+	}}
+	require.Error(t, config.ValidateBots())
+
+	config = &Config{ChainConfigs: []*ChainConfig{
+		{Name: "chain", DiscordConfig: DiscordConfig{Bot: "main"}},
+	}}
+	require.Error(t, config.ValidateBots())
+}
+
+func TestValidateBotsBotAndToken(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		TelegramBots: []*TelegramBotConfig{{Name: "main", Token: "token"}},
+		ChainConfigs: []*ChainConfig{
+			{Name: "chain", TelegramConfig: TelegramConfig{Bot: "main", Token: "token"}},
+		},
+	}
+	require.Error(t, config.ValidateBots())
+
+	config = &Config{
+		DiscordBots: []*DiscordBotConfig{{Name: "main", Token: "token", Guild: "guild"}},
+		ChainConfigs: []*ChainConfig{
+			{Name: "chain", DiscordConfig: DiscordConfig{Bot: "main", Token: "token"}},
+		},
+	}
+	require.Error(t, config.ValidateBots())
+}
+
+func TestValidateBotsPermissionsWithSharedBot(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		TelegramBots: []*TelegramBotConfig{{Name: "main", Token: "token"}},
+		ChainConfigs: []*ChainConfig{
+			{Name: "chain", TelegramConfig: TelegramConfig{Bot: "main", Admins: []int64{123}}},
+		},
+	}
+	require.Error(t, config.ValidateBots())
+
+	config = &Config{
+		DiscordBots: []*DiscordBotConfig{{Name: "main", Token: "token", Guild: "guild"}},
+		ChainConfigs: []*ChainConfig{
+			{Name: "chain", DiscordConfig: DiscordConfig{
+				Bot: "main",
+				DiscordPermissions: DiscordPermissions{
+					Permissions: map[string]DiscordCommandPermissions{"subscribe": {AllowedUsers: []string{"owner"}}},
+				},
+			}},
+		},
+	}
+	require.Error(t, config.ValidateBots())
+}
+
+func TestValidateBotsValid(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		TelegramBots: []*TelegramBotConfig{{Name: "main", Token: "token"}},
+		DiscordBots:  []*DiscordBotConfig{{Name: "main", Token: "token", Guild: "guild"}},
+		ChainConfigs: []*ChainConfig{
+			{
+				Name:           "chain1",
+				TelegramConfig: TelegramConfig{Bot: "main"},
+				DiscordConfig:  DiscordConfig{Bot: "main"},
+			},
+			{
+				Name:           "chain2",
+				TelegramConfig: TelegramConfig{Bot: "main"},
+				DiscordConfig:  DiscordConfig{Bot: "main"},
+			},
+		},
+	}
+	require.NoError(t, config.ValidateBots())
+}
@@ -16,16 +16,21 @@ type ChainPagination struct {
 	SigningInfos   uint64 `default:"1000" toml:"signing-infos"`
 }
 type ChainConfig struct {
-	Name               string          `toml:"name"`
-	PrettyName         string          `toml:"pretty-name"`
-	RPCEndpoints       []string        `toml:"rpc-endpoints"`
-	StoreBlocks        int64           `default:"20000"      toml:"store-blocks"`
-	BlocksWindow       int64           `default:"10000"      toml:"blocks-window"`
-	MinSignedPerWindow float64         `default:"0.05"       toml:"min-signed-per-window"`
-	SnapshotsInterval  int64           `default:"1"          toml:"snapshots-interval"`
-	FirstBlock         int64           `default:"1"          toml:"first-block"`
-	Pagination         ChainPagination `toml:"pagination"`
-	Intervals          IntervalsConfig `toml:"intervals"`
+	Name               string             `toml:"name"`
+	PrettyName         string             `toml:"pretty-name"`
+	RPCEndpoints       []string           `toml:"rpc-endpoints"`
+	StoreBlocks        int64              `default:"20000"      toml:"store-blocks"`
+	BlocksWindow       int64              `default:"10000"      toml:"blocks-window"`
+	MinSignedPerWindow float64            `default:"0.05"       toml:"min-signed-per-window"`
+	SnapshotsInterval  int64              `default:"1"          toml:"snapshots-interval"`
+	FirstBlock         int64              `default:"1"          toml:"first-block"`
+	Pagination         ChainPagination    `toml:"pagination"`
+	Intervals          IntervalsConfig    `toml:"intervals"`
+	OutboxConfig       OutboxConfig       `toml:"outbox"`
+	DigestConfigs      DigestConfigs      `toml:"digest"`
+	IncidentsConfig    IncidentsConfig    `toml:"incidents"`
+	TemplatesDir       string             `toml:"templates-dir"`
+	Language           constants.Language `default:"en" toml:"language"`
 
 	IsConsumer              null.Bool `default:"false"                  toml:"consumer"`
 	ProviderRPCEndpoints    []string  `toml:"provider-rpc-endpoints"`
@@ -41,9 +46,15 @@ type ChainConfig struct {
 	EmojisStart        []string           `default:"[\"🟡\", \"🟡\", \"🟡\", \"🟠\", \"🟠\", \"🟠\", \"🔴\", \"🔴\", \"🔴\"]"                            toml:"emoji-start"`
 	EmojisEnd          []string           `default:"[\"🟢\", \"🟡\", \"🟡\", \"🟡\", \"🟡\", \"🟠\", \"🟠\", \"🟠\", \"🟠\"]"                            toml:"emoji-end"`
 
-	ExplorerConfig ExplorerConfig `toml:"explorer"`
-	TelegramConfig TelegramConfig `toml:"telegram"`
-	DiscordConfig  DiscordConfig  `toml:"discord"`
+	ExplorerConfig     ExplorerConfig     `toml:"explorer"`
+	TelegramConfig     TelegramConfig     `toml:"telegram"`
+	DiscordConfig      DiscordConfig      `toml:"discord"`
+	SlackConfig        SlackConfig        `toml:"slack"`
+	MatrixConfig       MatrixConfig       `toml:"matrix"`
+	EmailConfig        EmailConfig        `toml:"email"`
+	WebhookConfig      WebhookConfig      `toml:"webhook"`
+	PagerDutyConfig    PagerDutyConfig    `toml:"pagerduty"`
+	AlertmanagerConfig AlertmanagerConfig `toml:"alertmanager"`
 }
 
 func (c *ChainConfig) GetName() string {
@@ -75,6 +86,18 @@ func (c *ChainConfig) Validate() error {
 		)
 	}
 
+	if c.Language != "" && !utils.Contains(constants.GetLanguages(), c.Language) {
+		languages := utils.Map(constants.GetLanguages(), func(language constants.Language) string {
+			return string(language)
+		})
+
+		return fmt.Errorf(
+			"expected language to be one of %s, but got %s",
+			strings.Join(languages, ", "),
+			c.Language,
+		)
+	}
+
 	if len(c.RPCEndpoints) == 0 {
 		return errors.New("chain has 0 RPC endpoints")
 	}
@@ -133,9 +156,49 @@ func (c *ChainConfig) Validate() error {
 		}
 	}
 
+	if err := c.OutboxConfig.Validate(); err != nil {
+		return fmt.Errorf("error in outbox config: %s", err)
+	}
+
+	if err := c.DigestConfigs.Validate(); err != nil {
+		return fmt.Errorf("error in digest config: %s", err)
+	}
+
+	if err := c.IncidentsConfig.Validate(); err != nil {
+		return fmt.Errorf("error in incidents config: %s", err)
+	}
+
+	if err := c.TelegramConfig.Validate(); err != nil {
+		return fmt.Errorf("error in telegram config: %s", err)
+	}
+
+	if err := c.DiscordConfig.Validate(); err != nil {
+		return fmt.Errorf("error in discord config: %s", err)
+	}
+
+	// incident threads are started off the incident messages, which are not kept without edit-messages
+	if c.DiscordConfig.HasIncidentThreads() && !c.IncidentsConfig.EditMessages.Bool {
+		return errors.New("discord incident threads are enabled, but incidents edit-messages is disabled")
+	}
+
+	if err := c.EmailConfig.Validate(); err != nil {
+		return fmt.Errorf("error in email config: %s", err)
+	}
+
+	if err := c.PagerDutyConfig.Validate(); err != nil {
+		return fmt.Errorf("error in pagerduty config: %s", err)
+	}
+
 	return nil
 }
 
+// IsIncidentGroup returns whether a validator in this missed blocks group
+// is considered to be in an incident that needs someone to handle it.
+func (c *ChainConfig) IsIncidentGroup(group *MissedBlocksGroup) bool {
+	totalRange := float64(c.BlocksWindow) + 1
+	return group.Start >= int64(totalRange*c.IncidentsConfig.Threshold/100)
+}
+
 func (c *ChainConfig) RecalculateMissedBlocksGroups() {
 	totalRange := float64(c.BlocksWindow) + 1 // from 0 till max blocks allowed, including
 
@@ -200,6 +200,22 @@ func TestValidateLCDWithoutLCDEndpoints(t *testing.T) {
 	require.Error(t, err, "Error should be present!")
 }
 
+func TestValidateChainInvalidLanguage(t *testing.T) {
+	t.Parallel()
+
+	config := &ChainConfig{
+		Name:         "chain",
+		RPCEndpoints: []string{"endpoint"},
+		FetcherType:  "cosmos-rpc",
+		Thresholds:   []float64{0, 50, 100},
+		EmojisStart:  []string{"x", "y"},
+		EmojisEnd:    []string{"x", "y"},
+		Language:     "xx",
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
 func TestValidateChainValid(t *testing.T) {
 	t.Parallel()
 
@@ -215,6 +231,28 @@ func TestValidateChainValid(t *testing.T) {
 	require.NoError(t, err, "Error should not be present!")
 }
 
+func TestValidateChainIncidentThreadsWithoutEditMessages(t *testing.T) {
+	t.Parallel()
+
+	config := &ChainConfig{
+		Name:            "chain",
+		RPCEndpoints:    []string{"endpoint"},
+		FetcherType:     "cosmos-rpc",
+		Thresholds:      []float64{0, 50, 100},
+		EmojisStart:     []string{"x", "y"},
+		EmojisEnd:       []string{"x", "y"},
+		IncidentsConfig: IncidentsConfig{EditMessages: null.BoolFrom(false)},
+		DiscordConfig: DiscordConfig{
+			Channel:      "channel",
+			Destinations: []DiscordDestination{{Channel: "other", IncidentThreads: true}},
+		},
+	}
+	require.Error(t, config.Validate(), "Error should be present!")
+
+	config.IncidentsConfig.EditMessages = null.BoolFrom(true)
+	require.NoError(t, config.Validate(), "Error should not be present!")
+}
+
 func TestValidateLCDChainValid(t *testing.T) {
 	t.Parallel()
 
@@ -10,10 +10,17 @@ import (
 )
 
 type Config struct {
-	LogConfig      LogConfig      `toml:"log"`
-	ChainConfigs   []*ChainConfig `toml:"chains"`
-	DatabaseConfig DatabaseConfig `toml:"database"`
-	MetricsConfig  MetricsConfig  `toml:"metrics"`
+	LogConfig      LogConfig            `toml:"log"`
+	ChainConfigs   []*ChainConfig       `toml:"chains"`
+	DatabaseConfig DatabaseConfig       `toml:"database"`
+	MetricsConfig  MetricsConfig        `toml:"metrics"`
+	TelegramBots   []*TelegramBotConfig `toml:"telegram-bots"`
+	DiscordBots    []*DiscordBotConfig  `toml:"discord-bots"`
+	APIConfig      APIConfig            `toml:"api"`
+
+	TelegramWebhookConfig TelegramWebhookConfig `toml:"telegram-webhook"`
+
+	TemplatesDir string `toml:"templates-dir"`
 }
 
 func (config *Config) Validate() error {
@@ -31,6 +38,18 @@ func (config *Config) Validate() error {
 		return fmt.Errorf("error in database config: %s", err)
 	}
 
+	if err := config.APIConfig.Validate(); err != nil {
+		return fmt.Errorf("error in API config: %s", err)
+	}
+
+	if err := config.TelegramWebhookConfig.Validate(); err != nil {
+		return fmt.Errorf("error in telegram webhook config: %s", err)
+	}
+
+	if err := config.ValidateBots(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -48,5 +67,12 @@ func GetConfig(path string, filesystem fs.FS) (*Config, error) {
 	}
 	defaults.MustSet(configStruct)
 
+	// chains without their own templates overrides use the global ones
+	for _, chainConfig := range configStruct.ChainConfigs {
+		if chainConfig.TemplatesDir == "" {
+			chainConfig.TemplatesDir = configStruct.TemplatesDir
+		}
+	}
+
 	return configStruct, nil
 }
@@ -48,6 +48,17 @@ func TestLoadConfigValid(t *testing.T) {
 	require.NotNil(t, config)
 }
 
+func TestLoadConfigTemplatesDir(t *testing.T) {
+	t.Parallel()
+
+	config, err := configPkg.GetConfig("config-templates-dir.toml", &TmpFSInterface{})
+
+	require.NoError(t, err)
+	require.Len(t, config.ChainConfigs, 2)
+	require.Equal(t, "/etc/missed-blocks-checker/templates", config.ChainConfigs[0].TemplatesDir)
+	require.Equal(t, "/etc/missed-blocks-checker/osmosis-templates", config.ChainConfigs[1].TemplatesDir)
+}
+
 func TestValidateConfigEmptyChains(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"time"
+)
+
+type DigestConfig struct {
+	Interval     time.Duration `toml:"interval"`
+	BypassEvents []string      `toml:"bypass-events"`
+}
+
+func (c *DigestConfig) ShouldBypass(event types.ReportEvent) bool {
+	return utils.Contains(c.BypassEvents, string(event.Type()))
+}
+
+func (c *DigestConfig) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval should be positive")
+	}
+
+	eventNames := utils.Map(constants.GetEventNames(), func(name constants.EventName) string {
+		return string(name)
+	})
+
+	for _, eventName := range c.BypassEvents {
+		if !utils.Contains(eventNames, eventName) {
+			return fmt.Errorf("unknown event name: %s", eventName)
+		}
+	}
+
+	return nil
+}
+
+type DigestConfigs map[constants.ReporterName]*DigestConfig
+
+func (c DigestConfigs) Validate() error {
+	for reporterName, digestConfig := range c {
+		if !utils.Contains(constants.GetReporterNames(), reporterName) {
+			return fmt.Errorf("unknown reporter name: %s", reporterName)
+		}
+
+		if err := digestConfig.Validate(); err != nil {
+			return fmt.Errorf("error in %s digest: %s", reporterName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestConfigShouldBypass(t *testing.T) {
+	t.Parallel()
+
+	config := &DigestConfig{Interval: 60, BypassEvents: []string{"ValidatorJailed"}}
+	validator := &types.Validator{OperatorAddress: "address"}
+
+	require.True(t, config.ShouldBypass(testEvent{eventType: constants.EventValidatorJailed, validator: validator}))
+	require.False(t, config.ShouldBypass(testEvent{eventType: constants.EventValidatorGroupChanged, validator: validator}))
+}
+
+func TestDigestConfigsValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DigestConfigs{}.Validate())
+	require.Error(t, DigestConfigs{"unknown": {Interval: 60}}.Validate())
+	require.Error(t, DigestConfigs{constants.TelegramReporterName: {Interval: 0}}.Validate())
+	require.Error(t, DigestConfigs{
+		constants.TelegramReporterName: {Interval: 60, BypassEvents: []string{"unknown"}},
+	}.Validate())
+	require.NoError(t, DigestConfigs{
+		constants.TelegramReporterName: {Interval: 60, BypassEvents: []string{"ValidatorJailed"}},
+	}.Validate())
+}
+
+func TestDigestConfigsDecode(t *testing.T) {
+	t.Parallel()
+
+	var config ChainConfig
+	_, err := toml.Decode(`
+[digest.telegram]
+interval = 300
+bypass-events = ["ValidatorJailed", "ValidatorTombstoned"]
+`, &config)
+	require.NoError(t, err)
+	require.Len(t, config.DigestConfigs, 1)
+	require.Equal(t, time.Duration(300), config.DigestConfigs[constants.TelegramReporterName].Interval)
+	require.Len(t, config.DigestConfigs[constants.TelegramReporterName].BypassEvents, 2)
+}
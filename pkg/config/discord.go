@@ -1,7 +1,137 @@
 package config
 
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+)
+
+// DiscordCommandPermissions lists the roles and users allowed to run a command.
+type DiscordCommandPermissions struct {
+	AllowedRoles []string `toml:"allowed-roles"`
+	AllowedUsers []string `toml:"allowed-users"`
+}
+
+func (p DiscordCommandPermissions) IsAllowed(userID string, roles []string) bool {
+	if utils.Contains(p.AllowedUsers, userID) {
+		return true
+	}
+
+	for _, role := range roles {
+		if utils.Contains(p.AllowedRoles, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DiscordPermissions restricts the bot commands, by their names, to the users with one
+// of the allowed roles and to the allowed users. The commands not listed there can be run by everyone.
+type DiscordPermissions struct {
+	Permissions map[string]DiscordCommandPermissions `toml:"permissions"`
+}
+
+func (p DiscordPermissions) IsRestricted() bool {
+	return len(p.Permissions) > 0
+}
+
+func (p DiscordPermissions) IsAllowed(command string, userID string, roles []string) bool {
+	commandPermissions, ok := p.Permissions[command]
+	if !ok {
+		return true
+	}
+
+	return commandPermissions.IsAllowed(userID, roles)
+}
+
+// IsGranted is like IsAllowed, but denies the action to everyone if it has no permissions entry.
+func (p DiscordPermissions) IsGranted(action string, userID string, roles []string) bool {
+	actionPermissions, ok := p.Permissions[action]
+	if !ok {
+		return false
+	}
+
+	return actionPermissions.IsAllowed(userID, roles)
+}
+
+func (p DiscordPermissions) Validate() error {
+	for command, commandPermissions := range p.Permissions {
+		if !utils.Contains(constants.GetDiscordCommands(), command) {
+			return fmt.Errorf(
+				"unknown command in permissions: %s, expected one of: %s",
+				command,
+				strings.Join(constants.GetDiscordCommands(), ", "),
+			)
+		}
+
+		if len(commandPermissions.AllowedRoles) == 0 && len(commandPermissions.AllowedUsers) == 0 {
+			return fmt.Errorf("command %s has neither allowed roles nor users in permissions", command)
+		}
+	}
+
+	return nil
+}
+
+type DiscordDestination struct {
+	Channel         string `toml:"channel"`
+	IncidentThreads bool   `toml:"incident-threads"`
+	EventFilter
+}
+
 type DiscordConfig struct {
-	Guild   string `toml:"guild"`
-	Token   string `toml:"token"`
-	Channel string `toml:"channel"`
+	Guild           string               `toml:"guild"`
+	Token           string               `toml:"token"`
+	Bot             string               `toml:"bot"`
+	Channel         string               `toml:"channel"`
+	IncidentThreads bool                 `toml:"incident-threads"`
+	Destinations    []DiscordDestination `toml:"destinations"`
+	DiscordPermissions
+}
+
+func (c *DiscordConfig) GetDestinations() []DiscordDestination {
+	destinations := make([]DiscordDestination, 0, len(c.Destinations)+1)
+
+	if c.Channel != "" {
+		destinations = append(destinations, DiscordDestination{
+			Channel:         c.Channel,
+			IncidentThreads: c.IncidentThreads,
+		})
+	}
+
+	return append(destinations, c.Destinations...)
+}
+
+// HasIncidentThreads returns whether any of the destinations starts a thread per incident.
+func (c *DiscordConfig) HasIncidentThreads() bool {
+	for _, destination := range c.GetDestinations() {
+		if destination.IncidentThreads {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *DiscordConfig) Validate() error {
+	if err := c.DiscordPermissions.Validate(); err != nil {
+		return err
+	}
+
+	if c.Channel == "" && c.IncidentThreads {
+		return fmt.Errorf("incident threads are enabled, but channel is not provided")
+	}
+
+	for index, destination := range c.Destinations {
+		if destination.Channel == "" {
+			return fmt.Errorf("destination #%d: channel is not provided", index)
+		}
+
+		if err := destination.Validate(); err != nil {
+			return fmt.Errorf("error in destination #%d: %s", index, err)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscordPermissionsNotRestricted(t *testing.T) {
+	t.Parallel()
+
+	permissions := DiscordPermissions{}
+	require.False(t, permissions.IsRestricted())
+	require.True(t, permissions.IsAllowed("subscribe", "user", nil))
+}
+
+func TestDiscordPermissionsRestricted(t *testing.T) {
+	t.Parallel()
+
+	permissions := DiscordPermissions{
+		Permissions: map[string]DiscordCommandPermissions{
+			"subscribe":   {AllowedRoles: []string{"admin"}, AllowedUsers: []string{"owner"}},
+			"maintenance": {AllowedUsers: []string{"owner"}},
+		},
+	}
+	require.True(t, permissions.IsRestricted())
+	require.True(t, permissions.IsAllowed("status", "user", nil))
+	require.False(t, permissions.IsAllowed("subscribe", "user", []string{"member"}))
+	require.True(t, permissions.IsAllowed("subscribe", "user", []string{"member", "admin"}))
+	require.True(t, permissions.IsAllowed("subscribe", "owner", nil))
+	require.False(t, permissions.IsAllowed("maintenance", "user", []string{"admin"}))
+	require.True(t, permissions.IsAllowed("maintenance", "owner", nil))
+}
+
+func TestDiscordPermissionsGranted(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, DiscordPermissions{}.IsGranted("subscribe-others", "owner", []string{"admin"}))
+
+	permissions := DiscordPermissions{
+		Permissions: map[string]DiscordCommandPermissions{
+			"subscribe-others": {AllowedRoles: []string{"admin"}, AllowedUsers: []string{"owner"}},
+		},
+	}
+	require.False(t, permissions.IsGranted("subscribe-others", "user", nil))
+	require.False(t, permissions.IsGranted("subscribe-others", "user", []string{"member", "moderator"}))
+	require.True(t, permissions.IsGranted("subscribe-others", "user", []string{"member", "admin"}))
+	require.True(t, permissions.IsGranted("subscribe-others", "owner", nil))
+}
+
+func TestDiscordPermissionsValidate(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, DiscordPermissions{
+		Permissions: map[string]DiscordCommandPermissions{"unknown": {AllowedUsers: []string{"owner"}}},
+	}.Validate())
+	require.Error(t, DiscordPermissions{
+		Permissions: map[string]DiscordCommandPermissions{"subscribe": {}},
+	}.Validate())
+	require.NoError(t, DiscordPermissions{
+		Permissions: map[string]DiscordCommandPermissions{"subscribe": {AllowedUsers: []string{"owner"}}},
+	}.Validate())
+	require.NoError(t, DiscordPermissions{
+		Permissions: map[string]DiscordCommandPermissions{"subscribe-others": {AllowedRoles: []string{"admin"}}},
+	}.Validate())
+	require.NoError(t, DiscordPermissions{}.Validate())
+}
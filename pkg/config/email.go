@@ -0,0 +1,64 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/utils"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type EmailConfig struct {
+	Host                string              `toml:"host"`
+	Port                int                 `default:"587"                                                                 toml:"port"`
+	Username            string              `toml:"username"`
+	Password            string              `toml:"password"`
+	StartTLS            null.Bool           `default:"true"                                                                toml:"starttls"`
+	From                string              `toml:"from"`
+	To                  []string            `toml:"to"`
+	ValidatorRecipients map[string][]string `toml:"validator-recipients"`
+	Events              []string            `default:"[\"ValidatorJailed\", \"ValidatorTombstoned\", \"ValidatorGroupChanged\"]" toml:"events"`
+}
+
+func (c *EmailConfig) Enabled() bool {
+	return c.Host != ""
+}
+
+func (c *EmailConfig) GetRecipients(operatorAddress string) []string {
+	recipients := make([]string, 0, len(c.To)+len(c.ValidatorRecipients[operatorAddress]))
+
+	for _, recipient := range append(c.To, c.ValidatorRecipients[operatorAddress]...) {
+		if !utils.Contains(recipients, recipient) {
+			recipients = append(recipients, recipient)
+		}
+	}
+
+	return recipients
+}
+
+func (c *EmailConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.From == "" {
+		return errors.New("sender address is not provided")
+	}
+
+	if len(c.To) == 0 && len(c.ValidatorRecipients) == 0 {
+		return errors.New("no recipients provided")
+	}
+
+	eventNames := utils.Map(constants.GetEventNames(), func(name constants.EventName) string {
+		return string(name)
+	})
+
+	for _, eventName := range c.Events {
+		if !utils.Contains(eventNames, eventName) {
+			return fmt.Errorf("unknown event name: %s", eventName)
+		}
+	}
+
+	return nil
+}
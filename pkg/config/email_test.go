@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEmailConfigDisabled(t *testing.T) {
+	t.Parallel()
+
+	config := &EmailConfig{}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestValidateEmailConfigWithoutFrom(t *testing.T) {
+	t.Parallel()
+
+	config := &EmailConfig{Host: "smtp.example.com", To: []string{"test@example.com"}}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateEmailConfigWithoutRecipients(t *testing.T) {
+	t.Parallel()
+
+	config := &EmailConfig{Host: "smtp.example.com", From: "bot@example.com"}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateEmailConfigInvalidEvent(t *testing.T) {
+	t.Parallel()
+
+	config := &EmailConfig{
+		Host:   "smtp.example.com",
+		From:   "bot@example.com",
+		To:     []string{"test@example.com"},
+		Events: []string{"test"},
+	}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidateEmailConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &EmailConfig{
+		Host:   "smtp.example.com",
+		From:   "bot@example.com",
+		To:     []string{"test@example.com"},
+		Events: []string{"ValidatorJailed"},
+	}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestEmailConfigGetRecipients(t *testing.T) {
+	t.Parallel()
+
+	config := &EmailConfig{
+		To: []string{"first@example.com"},
+		ValidatorRecipients: map[string][]string{
+			"cosmosvaloper1xxx": {"first@example.com", "second@example.com"},
+		},
+	}
+
+	require.Equal(
+		t,
+		[]string{"first@example.com", "second@example.com"},
+		config.GetRecipients("cosmosvaloper1xxx"),
+	)
+	require.Equal(t, []string{"first@example.com"}, config.GetRecipients("cosmosvaloper1yyy"))
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+)
+
+type EventFilter struct {
+	Events            []string `toml:"events"`
+	ExcludeEvents     []string `toml:"exclude-events"`
+	Validators        []string `toml:"validators"`
+	ExcludeValidators []string `toml:"exclude-validators"`
+}
+
+func (f *EventFilter) Matches(event types.ReportEvent) bool {
+	eventName := string(event.Type())
+	operatorAddress := event.GetValidator().OperatorAddress
+
+	if len(f.Events) > 0 && !utils.Contains(f.Events, eventName) {
+		return false
+	}
+
+	if utils.Contains(f.ExcludeEvents, eventName) {
+		return false
+	}
+
+	if len(f.Validators) > 0 && !utils.Contains(f.Validators, operatorAddress) {
+		return false
+	}
+
+	return !utils.Contains(f.ExcludeValidators, operatorAddress)
+}
+
+func (f *EventFilter) Filter(events []types.ReportEvent) []types.ReportEvent {
+	return utils.Filter(events, f.Matches)
+}
+
+func (f *EventFilter) Validate() error {
+	eventNames := utils.Map(constants.GetEventNames(), func(name constants.EventName) string {
+		return string(name)
+	})
+
+	for _, eventName := range append(f.Events, f.ExcludeEvents...) {
+		if !utils.Contains(eventNames, eventName) {
+			return fmt.Errorf("unknown event name: %s", eventName)
+		}
+	}
+
+	return nil
+}
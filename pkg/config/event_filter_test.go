@@ -0,0 +1,200 @@
+package config
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	eventType constants.EventName
+	validator *types.Validator
+}
+
+func (e testEvent) Type() constants.EventName {
+	return e.eventType
+}
+
+func (e testEvent) GetValidator() *types.Validator {
+	return e.validator
+}
+
+func TestEventFilterMatchesEmpty(t *testing.T) {
+	t.Parallel()
+
+	filter := &EventFilter{}
+	event := testEvent{eventType: constants.EventValidatorJailed, validator: &types.Validator{OperatorAddress: "address"}}
+	require.True(t, filter.Matches(event))
+}
+
+func TestEventFilterMatchesEvents(t *testing.T) {
+	t.Parallel()
+
+	filter := &EventFilter{
+		Events:        []string{"ValidatorJailed", "ValidatorTombstoned"},
+		ExcludeEvents: []string{"ValidatorTombstoned"},
+	}
+	validator := &types.Validator{OperatorAddress: "address"}
+
+	require.True(t, filter.Matches(testEvent{eventType: constants.EventValidatorJailed, validator: validator}))
+	require.False(t, filter.Matches(testEvent{eventType: constants.EventValidatorTombstoned, validator: validator}))
+	require.False(t, filter.Matches(testEvent{eventType: constants.EventValidatorGroupChanged, validator: validator}))
+}
+
+func TestEventFilterMatchesValidators(t *testing.T) {
+	t.Parallel()
+
+	filter := &EventFilter{
+		Validators:        []string{"address1", "address2"},
+		ExcludeValidators: []string{"address2"},
+	}
+
+	require.True(t, filter.Matches(testEvent{
+		eventType: constants.EventValidatorJailed,
+		validator: &types.Validator{OperatorAddress: "address1"},
+	}))
+	require.False(t, filter.Matches(testEvent{
+		eventType: constants.EventValidatorJailed,
+		validator: &types.Validator{OperatorAddress: "address2"},
+	}))
+	require.False(t, filter.Matches(testEvent{
+		eventType: constants.EventValidatorJailed,
+		validator: &types.Validator{OperatorAddress: "address3"},
+	}))
+}
+
+func TestEventFilterFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := &EventFilter{ExcludeEvents: []string{"ValidatorGroupChanged"}}
+	validator := &types.Validator{OperatorAddress: "address"}
+
+	filtered := filter.Filter([]types.ReportEvent{
+		testEvent{eventType: constants.EventValidatorJailed, validator: validator},
+		testEvent{eventType: constants.EventValidatorGroupChanged, validator: validator},
+	})
+	require.Len(t, filtered, 1)
+	require.Equal(t, constants.EventValidatorJailed, filtered[0].Type())
+}
+
+func TestEventFilterValidateInvalid(t *testing.T) {
+	t.Parallel()
+
+	filter := &EventFilter{ExcludeEvents: []string{"test"}}
+	err := filter.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestEventFilterValidateOk(t *testing.T) {
+	t.Parallel()
+
+	filter := &EventFilter{Events: []string{"ValidatorJailed"}}
+	err := filter.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestTelegramDestinationsDecode(t *testing.T) {
+	t.Parallel()
+
+	var config TelegramConfig
+	_, err := toml.Decode(`
+chat = 1
+destinations = [
+  { chat = 2, events = ["ValidatorJailed"], validators = ["address"] },
+]`, &config)
+	require.NoError(t, err, "Error should not be present!")
+
+	destinations := config.GetDestinations()
+	require.Len(t, destinations, 2)
+	require.Equal(t, int64(1), destinations[0].Chat)
+	require.Empty(t, destinations[0].Events)
+	require.Equal(t, int64(2), destinations[1].Chat)
+	require.Equal(t, []string{"ValidatorJailed"}, destinations[1].Events)
+	require.Equal(t, []string{"address"}, destinations[1].Validators)
+}
+
+func TestTelegramDestinationsTopics(t *testing.T) {
+	t.Parallel()
+
+	var config TelegramConfig
+	_, err := toml.Decode(`
+chat = -100123
+topic = 5
+incident-topics = true
+destinations = [
+  { chat = -100456 },
+]`, &config)
+	require.NoError(t, err, "Error should not be present!")
+	require.NoError(t, config.Validate(), "Error should not be present!")
+
+	destinations := config.GetDestinations()
+	require.Len(t, destinations, 2)
+	require.Equal(t, 5, destinations[0].Topic)
+	require.True(t, destinations[0].IncidentTopics)
+	require.Equal(t, "-100123/5", destinations[0].GetKey())
+	require.Equal(t, "-100456", destinations[1].GetKey())
+}
+
+func TestTelegramConfigValidateTopicWithoutChat(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramConfig{Topic: 5}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestTelegramConfigValidateNoChat(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramConfig{Destinations: []TelegramDestination{{}}}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestDiscordConfigValidateInvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	config := &DiscordConfig{Destinations: []DiscordDestination{
+		{Channel: "channel", EventFilter: EventFilter{Events: []string{"test"}}},
+	}}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestDiscordConfigGetDestinations(t *testing.T) {
+	t.Parallel()
+
+	config := &DiscordConfig{Destinations: []DiscordDestination{{Channel: "channel"}}}
+	require.Len(t, config.GetDestinations(), 1)
+	require.NoError(t, config.Validate(), "Error should not be present!")
+}
+
+func TestDiscordDestinationsIncidentThreads(t *testing.T) {
+	t.Parallel()
+
+	var config DiscordConfig
+	_, err := toml.Decode(`
+channel = "channel"
+incident-threads = true
+destinations = [
+  { channel = "other" },
+]`, &config)
+	require.NoError(t, err, "Error should not be present!")
+	require.NoError(t, config.Validate(), "Error should not be present!")
+
+	destinations := config.GetDestinations()
+	require.Len(t, destinations, 2)
+	require.True(t, destinations[0].IncidentThreads)
+	require.False(t, destinations[1].IncidentThreads)
+}
+
+func TestDiscordConfigValidateIncidentThreadsWithoutChannel(t *testing.T) {
+	t.Parallel()
+
+	config := &DiscordConfig{IncidentThreads: true}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+type IncidentsConfig struct {
+	Threshold      float64       `default:"50"   toml:"threshold"`
+	RemindInterval time.Duration `default:"0"    toml:"remind-interval"`
+	EditMessages   null.Bool     `default:"true" toml:"edit-messages"`
+}
+
+func (c *IncidentsConfig) RemindersEnabled() bool {
+	return c.RemindInterval > 0
+}
+
+func (c *IncidentsConfig) GetRemindInterval() time.Duration {
+	return c.RemindInterval * time.Second
+}
+
+func (c *IncidentsConfig) Validate() error {
+	if c.Threshold < 0 || c.Threshold > 100 {
+		return errors.New("threshold should be between 0 and 100")
+	}
+
+	if c.RemindInterval < 0 {
+		return errors.New("remind-interval should not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentsConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, (&IncidentsConfig{Threshold: -1}).Validate())
+	require.Error(t, (&IncidentsConfig{Threshold: 101}).Validate())
+	require.Error(t, (&IncidentsConfig{Threshold: 50, RemindInterval: -1}).Validate())
+	require.NoError(t, (&IncidentsConfig{Threshold: 50}).Validate())
+	require.NoError(t, (&IncidentsConfig{Threshold: 50, RemindInterval: 1800}).Validate())
+}
+
+func TestIncidentsConfigRemindInterval(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, (&IncidentsConfig{}).RemindersEnabled())
+
+	config := &IncidentsConfig{RemindInterval: 1800}
+	require.True(t, config.RemindersEnabled())
+	require.Equal(t, 30*time.Minute, config.GetRemindInterval())
+}
+
+func TestChainIsIncidentGroup(t *testing.T) {
+	t.Parallel()
+
+	config := &ChainConfig{
+		BlocksWindow:    100,
+		Thresholds:      []float64{0, 25, 50, 100},
+		EmojisStart:     []string{"x", "y", "z"},
+		EmojisEnd:       []string{"x", "y", "z"},
+		IncidentsConfig: IncidentsConfig{Threshold: 50},
+	}
+	config.RecalculateMissedBlocksGroups()
+
+	require.False(t, config.IsIncidentGroup(config.MissedBlocksGroups[0]))
+	require.False(t, config.IsIncidentGroup(config.MissedBlocksGroups[1]))
+	require.True(t, config.IsIncidentGroup(config.MissedBlocksGroups[2]))
+}
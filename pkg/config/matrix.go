@@ -0,0 +1,8 @@
+package config
+
+type MatrixConfig struct {
+	Homeserver string   `toml:"homeserver"`
+	Token      string   `toml:"token"`
+	Room       string   `toml:"room"`
+	Admins     []string `toml:"admins"`
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+type OutboxConfig struct {
+	Interval         time.Duration `default:"10"    toml:"interval"`
+	RetryInterval    time.Duration `default:"5"     toml:"retry-interval"`
+	MaxRetryInterval time.Duration `default:"3600"  toml:"max-retry-interval"`
+	MaxAttempts      int           `default:"20"    toml:"max-attempts"`
+	KeepDelivered    time.Duration `default:"86400" toml:"keep-delivered"`
+}
+
+func (c *OutboxConfig) GetRetryDelay(attempts int) time.Duration {
+	delay := c.RetryInterval * time.Second
+	maxDelay := c.MaxRetryInterval * time.Second
+
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+
+	return delay
+}
+
+func (c *OutboxConfig) Validate() error {
+	if c.MaxRetryInterval < c.RetryInterval {
+		return errors.New("max-retry-interval should not be less than retry-interval")
+	}
+
+	if c.MaxAttempts < 0 {
+		return errors.New("max-attempts should not be negative")
+	}
+
+	return nil
+}
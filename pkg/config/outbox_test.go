@@ -0,0 +1,29 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxConfigGetRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	config := &OutboxConfig{RetryInterval: 5, MaxRetryInterval: 60}
+
+	require.Equal(t, 5*time.Second, config.GetRetryDelay(1))
+	require.Equal(t, 10*time.Second, config.GetRetryDelay(2))
+	require.Equal(t, 20*time.Second, config.GetRetryDelay(3))
+	require.Equal(t, 40*time.Second, config.GetRetryDelay(4))
+	require.Equal(t, 60*time.Second, config.GetRetryDelay(5))
+	require.Equal(t, 60*time.Second, config.GetRetryDelay(100))
+}
+
+func TestOutboxConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.Error(t, (&OutboxConfig{Interval: 10, RetryInterval: 5, MaxRetryInterval: 1}).Validate())
+	require.Error(t, (&OutboxConfig{Interval: 10, RetryInterval: 5, MaxRetryInterval: 60, MaxAttempts: -1}).Validate())
+	require.NoError(t, (&OutboxConfig{Interval: 10, RetryInterval: 5, MaxRetryInterval: 60}).Validate())
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+)
+
+type PagerDutyConfig struct {
+	RoutingKey       string            `toml:"routing-key"`
+	APIURL           string            `default:"https://events.pagerduty.com" toml:"api-url"`
+	TriggerThreshold float64           `default:"25"                           toml:"trigger-threshold"`
+	Severities       map[string]string `toml:"severities"`
+}
+
+func (c *PagerDutyConfig) GetSeverity(eventName constants.EventName) string {
+	if severity, ok := c.Severities[string(eventName)]; ok {
+		return severity
+	}
+
+	switch eventName {
+	case constants.EventValidatorJailed, constants.EventValidatorTombstoned:
+		return constants.PagerDutySeverityCritical
+	case constants.EventValidatorGroupChanged:
+		return constants.PagerDutySeverityError
+	default:
+		return constants.PagerDutySeverityWarning
+	}
+}
+
+func (c *PagerDutyConfig) Validate() error {
+	if c.TriggerThreshold < 0 || c.TriggerThreshold > 100 {
+		return fmt.Errorf("trigger-threshold should be between 0 and 100, but got %.2f", c.TriggerThreshold)
+	}
+
+	eventNames := utils.Map(constants.GetEventNames(), func(name constants.EventName) string {
+		return string(name)
+	})
+
+	for eventName, severity := range c.Severities {
+		if !utils.Contains(eventNames, eventName) {
+			return fmt.Errorf("unknown event name in severities: %s", eventName)
+		}
+
+		if !utils.Contains(constants.GetPagerDutySeverities(), severity) {
+			return fmt.Errorf(
+				"expected severity for %s to be one of %s, but got %s",
+				eventName,
+				strings.Join(constants.GetPagerDutySeverities(), ", "),
+				severity,
+			)
+		}
+	}
+
+	return nil
+}
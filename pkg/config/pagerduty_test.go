@@ -0,0 +1,53 @@
+package config
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePagerDutyConfigInvalidThreshold(t *testing.T) {
+	t.Parallel()
+
+	config := &PagerDutyConfig{TriggerThreshold: 150}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidatePagerDutyConfigInvalidEventName(t *testing.T) {
+	t.Parallel()
+
+	config := &PagerDutyConfig{Severities: map[string]string{"test": "critical"}}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidatePagerDutyConfigInvalidSeverity(t *testing.T) {
+	t.Parallel()
+
+	config := &PagerDutyConfig{Severities: map[string]string{"ValidatorJailed": "test"}}
+	err := config.Validate()
+	require.Error(t, err, "Error should be present!")
+}
+
+func TestValidatePagerDutyConfigOk(t *testing.T) {
+	t.Parallel()
+
+	config := &PagerDutyConfig{
+		TriggerThreshold: 25,
+		Severities:       map[string]string{"ValidatorJailed": "error"},
+	}
+	err := config.Validate()
+	require.NoError(t, err, "Error should not be present!")
+}
+
+func TestPagerDutyConfigGetSeverity(t *testing.T) {
+	t.Parallel()
+
+	config := &PagerDutyConfig{Severities: map[string]string{"ValidatorJailed": "error"}}
+	require.Equal(t, "error", config.GetSeverity(constants.EventValidatorJailed))
+	require.Equal(t, "critical", config.GetSeverity(constants.EventValidatorTombstoned))
+	require.Equal(t, "error", config.GetSeverity(constants.EventValidatorGroupChanged))
+	require.Equal(t, "warning", config.GetSeverity(constants.EventValidatorUnjailed))
+}
@@ -0,0 +1,10 @@
+package config
+
+type SlackConfig struct {
+	Token         string `toml:"token"`
+	Channel       string `toml:"channel"`
+	WebhookURL    string `toml:"webhook-url"`
+	SigningSecret string `toml:"signing-secret"`
+	ListenAddr    string `toml:"listen-addr"`
+	Path          string `default:"/slack/commands" toml:"path"`
+}
@@ -1,7 +1,65 @@
 package config
 
+import (
+	"fmt"
+	"strconv"
+)
+
+type TelegramDestination struct {
+	Chat           int64 `toml:"chat"`
+	Topic          int   `toml:"topic"`
+	IncidentTopics bool  `toml:"incident-topics"`
+	EventFilter
+}
+
+// GetKey returns the destination identifier, which is the chat ID,
+// or the chat ID and the topic ID if it's posting to a forum topic.
+func (d TelegramDestination) GetKey() string {
+	if d.Topic != 0 {
+		return fmt.Sprintf("%d/%d", d.Chat, d.Topic)
+	}
+
+	return strconv.FormatInt(d.Chat, 10)
+}
+
 type TelegramConfig struct {
-	Chat   int64   `toml:"chat"`
-	Token  string  `toml:"token"`
-	Admins []int64 `toml:"admins"`
+	Chat           int64                 `toml:"chat"`
+	Topic          int                   `toml:"topic"`
+	IncidentTopics bool                  `toml:"incident-topics"`
+	Token          string                `toml:"token"`
+	Bot            string                `toml:"bot"`
+	Admins         []int64               `toml:"admins"`
+	Destinations   []TelegramDestination `toml:"destinations"`
+}
+
+func (c *TelegramConfig) GetDestinations() []TelegramDestination {
+	destinations := make([]TelegramDestination, 0, len(c.Destinations)+1)
+
+	if c.Chat != 0 {
+		destinations = append(destinations, TelegramDestination{
+			Chat:           c.Chat,
+			Topic:          c.Topic,
+			IncidentTopics: c.IncidentTopics,
+		})
+	}
+
+	return append(destinations, c.Destinations...)
+}
+
+func (c *TelegramConfig) Validate() error {
+	if c.Chat == 0 && (c.Topic != 0 || c.IncidentTopics) {
+		return fmt.Errorf("topic settings are provided, but chat is not")
+	}
+
+	for index, destination := range c.Destinations {
+		if destination.Chat == 0 {
+			return fmt.Errorf("destination #%d: chat is not provided", index)
+		}
+
+		if err := destination.Validate(); err != nil {
+			return fmt.Errorf("error in destination #%d: %s", index, err)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Telegram only allows these characters in the webhook secret token.
+var telegramSecretTokenRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
+type TelegramWebhookConfig struct {
+	ListenAddr  string `toml:"listen-addr"`
+	PublicURL   string `toml:"public-url"`
+	Path        string `default:"/telegram" toml:"path"`
+	SecretToken string `toml:"secret-token"`
+}
+
+func (c *TelegramWebhookConfig) Enabled() bool {
+	return c.ListenAddr != ""
+}
+
+// GetBotPath returns the path the bot's updates are received on, as each bot has its own webhook.
+func (c *TelegramWebhookConfig) GetBotPath(name string) string {
+	return strings.TrimRight(c.Path, "/") + "/" + url.PathEscape(name)
+}
+
+func (c *TelegramWebhookConfig) GetBotURL(name string) string {
+	return strings.TrimRight(c.PublicURL, "/") + c.GetBotPath(name)
+}
+
+func (c *TelegramWebhookConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.PublicURL == "" {
+		return errors.New("public URL is required when the webhook is enabled")
+	}
+
+	publicURL, err := url.Parse(c.PublicURL)
+	if err != nil {
+		return err
+	}
+
+	if publicURL.Scheme != "https" || publicURL.Host == "" {
+		return errors.New("public URL should be an absolute HTTPS URL")
+	}
+
+	if !strings.HasPrefix(c.Path, "/") {
+		return errors.New("path should start with a slash")
+	}
+
+	if c.SecretToken == "" {
+		return errors.New("secret token is required when the webhook is enabled")
+	}
+
+	if !telegramSecretTokenRegexp.MatchString(c.SecretToken) {
+		return errors.New("secret token should only contain letters, digits, underscores and hyphens")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramWebhookConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&TelegramWebhookConfig{}).Validate())
+	require.Error(t, (&TelegramWebhookConfig{ListenAddr: ":9590", Path: "/telegram"}).Validate())
+	require.Error(t, (&TelegramWebhookConfig{
+		ListenAddr: ":9590",
+		PublicURL:  "http://example.com",
+		Path:       "/telegram",
+	}).Validate())
+	require.Error(t, (&TelegramWebhookConfig{
+		ListenAddr: ":9590",
+		PublicURL:  "https://example.com",
+		Path:       "telegram",
+	}).Validate())
+	require.Error(t, (&TelegramWebhookConfig{
+		ListenAddr: ":9590",
+		PublicURL:  "https://example.com",
+		Path:       "/telegram",
+	}).Validate())
+	require.Error(t, (&TelegramWebhookConfig{
+		ListenAddr:  ":9590",
+		PublicURL:   "https://example.com",
+		Path:        "/telegram",
+		SecretToken: "not a valid token",
+	}).Validate())
+	require.NoError(t, (&TelegramWebhookConfig{
+		ListenAddr:  ":9590",
+		PublicURL:   "https://example.com",
+		Path:        "/telegram",
+		SecretToken: "secret_token-123",
+	}).Validate())
+}
+
+func TestTelegramWebhookConfigGetBotURL(t *testing.T) {
+	t.Parallel()
+
+	config := &TelegramWebhookConfig{PublicURL: "https://example.com/", Path: "/telegram/"}
+	require.Equal(t, "/telegram/main", config.GetBotPath("main"))
+	require.Equal(t, "https://example.com/telegram/main", config.GetBotURL("main"))
+}
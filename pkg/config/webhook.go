@@ -0,0 +1,6 @@
+package config
+
+type WebhookConfig struct {
+	URLs   []string `toml:"urls"`
+	Secret string   `toml:"secret"`
+}
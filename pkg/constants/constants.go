@@ -3,8 +3,11 @@ package constants
 type EventName string
 type ReporterName string
 type QueryType string
-type FormatType string
 type PopulatorType string
+type DeliveryMode string
+type Language string
+type OutboxStatus string
+type BlockStatus string
 
 const (
 	NewBlocksQuery = "tm.event='NewBlock'"
@@ -28,10 +31,18 @@ const (
 	EventValidatorChangedKey        EventName = "ValidatorChangedKey"
 	EventValidatorChangedMoniker    EventName = "ValidatorChangedMoniker"
 	EventValidatorChangedCommission EventName = "ValidatorChangedCommission"
-
-	TelegramReporterName ReporterName = "telegram"
-	DiscordReporterName  ReporterName = "discord"
-	TestReporterName     ReporterName = "test"
+	EventValidatorMaintenanceOver   EventName = "ValidatorMaintenanceOver"
+	EventValidatorIncidentReminder  EventName = "ValidatorIncidentReminder"
+
+	TelegramReporterName     ReporterName = "telegram"
+	DiscordReporterName      ReporterName = "discord"
+	SlackReporterName        ReporterName = "slack"
+	MatrixReporterName       ReporterName = "matrix"
+	EmailReporterName        ReporterName = "email"
+	WebhookReporterName      ReporterName = "webhook"
+	PagerDutyReporterName    ReporterName = "pagerduty"
+	AlertmanagerReporterName ReporterName = "alertmanager"
+	TestReporterName         ReporterName = "test"
 
 	QueryTypeValidators    QueryType = "validators"
 	QueryTypeSigningInfos  QueryType = "signing_infos"
@@ -42,9 +53,9 @@ const (
 	QueryTypeHistoricalValidators QueryType = "historical_validators"
 	QueryTypeBlock                QueryType = "block"
 
-	FormatTypeHTML     FormatType = "html"
-	FormatTypeMarkdown FormatType = "markdown"
-	FormatTypeTest     FormatType = "test"
+	QueryTypeWebhook      QueryType = "webhook"
+	QueryTypePagerDuty    QueryType = "pagerduty"
+	QueryTypeAlertmanager QueryType = "alertmanager"
 
 	DatabaseTypeSqlite   string = "sqlite"
 	DatabaseTypePostgres string = "postgres"
@@ -54,6 +65,31 @@ const (
 
 	PopulatorSlashingParams = "slashing-params-populator"
 	PopulatorTrimDatabase   = "trim-database-populator"
+	PopulatorOutbox         = "outbox-populator"
+
+	DeliveryModeChannel DeliveryMode = "channel"
+	DeliveryModeDM      DeliveryMode = "dm"
+	DeliveryModeBoth    DeliveryMode = "both"
+
+	LanguageEnglish Language = "en"
+	LanguageRussian Language = "ru"
+	LanguageSpanish Language = "es"
+	LanguageChinese Language = "zh"
+
+	OutboxStatusPending OutboxStatus = "pending"
+	OutboxStatusSent    OutboxStatus = "sent"
+	OutboxStatusFailed  OutboxStatus = "failed"
+	OutboxStatusDigest  OutboxStatus = "digest"
+
+	BlockStatusSigned    BlockStatus = "signed"
+	BlockStatusMissed    BlockStatus = "missed"
+	BlockStatusNotActive BlockStatus = "not_active"
+	BlockStatusUnknown   BlockStatus = "unknown"
+
+	PagerDutySeverityCritical = "critical"
+	PagerDutySeverityError    = "error"
+	PagerDutySeverityWarning  = "warning"
+	PagerDutySeverityInfo     = "info"
 )
 
 func GetEventNames() []EventName {
@@ -70,6 +106,8 @@ func GetEventNames() []EventName {
 		EventValidatorChangedCommission,
 		EventValidatorCreated,
 		EventValidatorGroupChanged,
+		EventValidatorMaintenanceOver,
+		EventValidatorIncidentReminder,
 	}
 }
 
@@ -86,3 +124,69 @@ func GetFetcherTypes() []string {
 		FetcherTypeCosmosLCD,
 	}
 }
+
+func GetPagerDutySeverities() []string {
+	return []string{
+		PagerDutySeverityCritical,
+		PagerDutySeverityError,
+		PagerDutySeverityWarning,
+		PagerDutySeverityInfo,
+	}
+}
+
+func GetDeliveryModes() []DeliveryMode {
+	return []DeliveryMode{
+		DeliveryModeChannel,
+		DeliveryModeDM,
+		DeliveryModeBoth,
+	}
+}
+
+func GetLanguages() []Language {
+	return []Language{
+		LanguageEnglish,
+		LanguageRussian,
+		LanguageSpanish,
+		LanguageChinese,
+	}
+}
+
+func GetReporterNames() []ReporterName {
+	return []ReporterName{
+		TelegramReporterName,
+		DiscordReporterName,
+		SlackReporterName,
+		MatrixReporterName,
+		EmailReporterName,
+		WebhookReporterName,
+		PagerDutyReporterName,
+		AlertmanagerReporterName,
+	}
+}
+
+// DiscordSubscribeOthersPermission is the Discord permissions entry for (un)subscribing
+// other users, which is denied to everyone not listed there.
+const DiscordSubscribeOthersPermission = "subscribe-others"
+
+// GetDiscordCommands returns the Discord bot commands that can be restricted with permissions,
+// including acknowledging incidents with the buttons and subscribing other users.
+func GetDiscordCommands() []string {
+	return []string{
+		"subscribe",
+		"unsubscribe",
+		"delivery",
+		"lang",
+		"mute",
+		"unmute",
+		"quiet",
+		"status",
+		"missing",
+		"chart",
+		"validators",
+		"params",
+		"notifiers",
+		"maintenance",
+		"ack",
+		DiscordSubscribeOthersPermission,
+	}
+}
@@ -2,10 +2,13 @@ package database
 
 import (
 	"encoding/json"
+	"fmt"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
+	"main/pkg/events"
 	snapshotPkg "main/pkg/snapshot"
 	"main/pkg/types"
+	"strings"
 	"sync"
 	"time"
 
@@ -176,7 +179,7 @@ func (d *Database) GetAllNotifiers(chain string) (*types.Notifiers, error) {
 	notifiers := make(types.Notifiers, 0)
 
 	rows, err := d.client.Query(
-		"SELECT operator_address, reporter, user_id, user_name FROM notifiers WHERE chain = $1",
+		"SELECT operator_address, reporter, user_id, user_name, delivery_mode, language, muted_until, quiet_hours_start, quiet_hours_end, quiet_hours_timezone, min_missed_percent, events FROM notifiers WHERE chain = $1",
 		chain,
 	)
 	if err != nil {
@@ -194,19 +197,53 @@ func (d *Database) GetAllNotifiers(chain string) (*types.Notifiers, error) {
 			reporter        constants.ReporterName
 			userID          string
 			userName        string
+			deliveryMode    constants.DeliveryMode
+			language        constants.Language
+			mutedUntil      int64
+			quietHours      types.QuietHours
+			filters         types.NotifierFilters
+			events          string
 		)
 
-		err = rows.Scan(&operatorAddress, &reporter, &userID, &userName)
+		err = rows.Scan(
+			&operatorAddress,
+			&reporter,
+			&userID,
+			&userName,
+			&deliveryMode,
+			&language,
+			&mutedUntil,
+			&quietHours.Start,
+			&quietHours.End,
+			&quietHours.Timezone,
+			&filters.MinMissedPercent,
+			&events,
+		)
 		if err != nil {
 			d.logger.Error().Err(err).Msg("Error fetching notifier data")
 			return &notifiers, err
 		}
 
+		if events != "" {
+			if filters.Events, err = types.ParseEventNames(events); err != nil {
+				d.logger.Error().Err(err).Msg("Error parsing notifier events")
+				return &notifiers, err
+			}
+		}
+
 		newNotifier := &types.Notifier{
 			OperatorAddress: operatorAddress,
 			Reporter:        reporter,
 			UserID:          userID,
 			UserName:        userName,
+			DeliveryMode:    deliveryMode,
+			Language:        language,
+			QuietHours:      quietHours,
+			Filters:         filters,
+		}
+
+		if mutedUntil > 0 {
+			newNotifier.MutedUntil = time.Unix(mutedUntil, 0)
 		}
 
 		notifiers = append(notifiers, newNotifier)
@@ -221,17 +258,28 @@ func (d *Database) InsertNotifier(
 	reporter constants.ReporterName,
 	userID string,
 	userName string,
+	deliveryMode constants.DeliveryMode,
+	language constants.Language,
+	quietHours types.QuietHours,
+	filters types.NotifierFilters,
 ) error {
 	d.MaybeMutexLock()
 	defer d.MaybeMutexUnlock()
 
 	_, err := d.client.Exec(
-		"INSERT INTO notifiers (chain, operator_address, reporter, user_id, user_name) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING",
+		"INSERT INTO notifiers (chain, operator_address, reporter, user_id, user_name, delivery_mode, language, quiet_hours_start, quiet_hours_end, quiet_hours_timezone, min_missed_percent, events) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT DO NOTHING",
 		chain,
 		operatorAddress,
 		reporter,
 		userID,
 		userName,
+		deliveryMode,
+		language,
+		quietHours.Start,
+		quietHours.End,
+		quietHours.Timezone,
+		filters.MinMissedPercent,
+		filters.SerializeEvents(),
 	)
 	if err != nil {
 		d.logger.Error().Err(err).Msg("Could not insert notifier")
@@ -264,6 +312,464 @@ func (d *Database) RemoveNotifier(
 
 	return nil
 }
+
+func (d *Database) UpdateNotifiersDeliveryMode(
+	chain string,
+	reporter constants.ReporterName,
+	userID string,
+	deliveryMode constants.DeliveryMode,
+) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE notifiers SET delivery_mode = $1 WHERE reporter = $2 AND user_id = $3 AND chain = $4",
+		deliveryMode,
+		reporter,
+		userID,
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update notifiers delivery mode")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) UpdateNotifiersLanguage(
+	chain string,
+	reporter constants.ReporterName,
+	userID string,
+	language constants.Language,
+) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE notifiers SET language = $1 WHERE reporter = $2 AND user_id = $3 AND chain = $4",
+		language,
+		reporter,
+		userID,
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update notifiers language")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) UpdateNotifiersMutedUntil(
+	chain string,
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	mutedUntil time.Time,
+) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	var mutedUntilUnix int64
+	if !mutedUntil.IsZero() {
+		mutedUntilUnix = mutedUntil.Unix()
+	}
+
+	query := "UPDATE notifiers SET muted_until = $1 WHERE reporter = $2 AND user_id = $3 AND chain = $4"
+	args := []any{mutedUntilUnix, reporter, userID, chain}
+
+	if operatorAddress != "" {
+		query += " AND operator_address = $5"
+		args = append(args, operatorAddress)
+	}
+
+	if _, err := d.client.Exec(query, args...); err != nil {
+		d.logger.Error().Err(err).Msg("Could not update notifiers mute")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) UpdateNotifiersQuietHours(
+	chain string,
+	reporter constants.ReporterName,
+	userID string,
+	quietHours types.QuietHours,
+) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE notifiers SET quiet_hours_start = $1, quiet_hours_end = $2, quiet_hours_timezone = $3 WHERE reporter = $4 AND user_id = $5 AND chain = $6",
+		quietHours.Start,
+		quietHours.End,
+		quietHours.Timezone,
+		reporter,
+		userID,
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update notifiers quiet hours")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) UpdateNotifierFilters(
+	chain string,
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	filters types.NotifierFilters,
+) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE notifiers SET min_missed_percent = $1, events = $2 WHERE operator_address = $3 AND reporter = $4 AND user_id = $5 AND chain = $6",
+		filters.MinMissedPercent,
+		filters.SerializeEvents(),
+		operatorAddress,
+		reporter,
+		userID,
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update notifier filters")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) GetAllMaintenanceWindows(chain string) ([]*types.MaintenanceWindow, error) {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	windows := make([]*types.MaintenanceWindow, 0)
+
+	rows, err := d.client.Query(
+		"SELECT operator_address, start_time, end_time, start_height, end_height, created_by FROM maintenance_windows WHERE chain = $1",
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting maintenance windows")
+		return windows, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err() // or modify return value
+	}()
+
+	for rows.Next() {
+		var (
+			window    types.MaintenanceWindow
+			startTime int64
+			endTime   int64
+		)
+
+		err = rows.Scan(
+			&window.OperatorAddress,
+			&startTime,
+			&endTime,
+			&window.StartHeight,
+			&window.EndHeight,
+			&window.CreatedBy,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error fetching maintenance window data")
+			return windows, err
+		}
+
+		if startTime > 0 {
+			window.StartTime = time.Unix(startTime, 0)
+		}
+		if endTime > 0 {
+			window.EndTime = time.Unix(endTime, 0)
+		}
+
+		windows = append(windows, &window)
+	}
+
+	return windows, nil
+}
+
+func (d *Database) UpsertMaintenanceWindow(chain string, window *types.MaintenanceWindow) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	var startTime, endTime int64
+	if !window.StartTime.IsZero() {
+		startTime = window.StartTime.Unix()
+	}
+	if !window.EndTime.IsZero() {
+		endTime = window.EndTime.Unix()
+	}
+
+	_, err := d.client.Exec(
+		"INSERT INTO maintenance_windows (chain, operator_address, start_time, end_time, start_height, end_height, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7) "+
+			"ON CONFLICT (chain, operator_address) DO UPDATE SET start_time = $3, end_time = $4, start_height = $5, end_height = $6, created_by = $7",
+		chain,
+		window.OperatorAddress,
+		startTime,
+		endTime,
+		window.StartHeight,
+		window.EndHeight,
+		window.CreatedBy,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save maintenance window")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) RemoveMaintenanceWindow(chain string, operatorAddress string) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"DELETE FROM maintenance_windows WHERE chain = $1 AND operator_address = $2",
+		chain,
+		operatorAddress,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete maintenance window")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) GetOpenIncidents(chain string) ([]*types.Incident, error) {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	incidents := make([]*types.Incident, 0)
+
+	rows, err := d.client.Query(
+		"SELECT id, operator_address, started_at, acked_by, acked_at, reminded_at FROM incidents WHERE chain = $1 AND resolved_at = 0",
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting incidents")
+		return incidents, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err() // or modify return value
+	}()
+
+	for rows.Next() {
+		var (
+			incident   types.Incident
+			startedAt  int64
+			ackedAt    int64
+			remindedAt int64
+		)
+
+		err = rows.Scan(
+			&incident.ID,
+			&incident.OperatorAddress,
+			&startedAt,
+			&incident.AckedBy,
+			&ackedAt,
+			&remindedAt,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error fetching incident data")
+			return incidents, err
+		}
+
+		incident.StartedAt = time.Unix(startedAt, 0)
+		if ackedAt > 0 {
+			incident.AckedAt = time.Unix(ackedAt, 0)
+		}
+		if remindedAt > 0 {
+			incident.RemindedAt = time.Unix(remindedAt, 0)
+		}
+
+		incidents = append(incidents, &incident)
+	}
+
+	return incidents, nil
+}
+
+func (d *Database) InsertIncident(chain string, incident *types.Incident) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	err := d.client.QueryRow(
+		"INSERT INTO incidents (chain, operator_address, started_at) VALUES ($1, $2, $3) RETURNING id",
+		chain,
+		incident.OperatorAddress,
+		incident.StartedAt.Unix(),
+	).Scan(&incident.ID)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not insert incident")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) UpdateIncidentAck(chain string, incident *types.Incident) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE incidents SET acked_by = $1, acked_at = $2 WHERE chain = $3 AND id = $4",
+		incident.AckedBy,
+		incident.AckedAt.Unix(),
+		chain,
+		incident.ID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update incident acknowledgement")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) UpdateIncidentReminder(chain string, incident *types.Incident) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE incidents SET reminded_at = $1 WHERE chain = $2 AND id = $3",
+		incident.RemindedAt.Unix(),
+		chain,
+		incident.ID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not update incident reminder")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) ResolveIncident(
+	chain string,
+	incidentID int64,
+	resolvedAt time.Time,
+	outcome types.IncidentOutcome,
+) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"UPDATE incidents SET resolved_at = $1, outcome = $2 WHERE chain = $3 AND id = $4",
+		resolvedAt.Unix(),
+		string(outcome),
+		chain,
+		incidentID,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not resolve incident")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) GetAllIncidentMessages(chain string) ([]*types.IncidentMessage, error) {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	messages := make([]*types.IncidentMessage, 0)
+
+	rows, err := d.client.Query(
+		"SELECT reporter, destination, operator_address, incident_id, message_id, thread_id, progression FROM incident_messages WHERE chain = $1",
+		chain,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error getting incident messages")
+		return messages, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err() // or modify return value
+	}()
+
+	for rows.Next() {
+		var (
+			message     types.IncidentMessage
+			progression string
+		)
+
+		err = rows.Scan(
+			&message.Reporter,
+			&message.Destination,
+			&message.OperatorAddress,
+			&message.IncidentID,
+			&message.MessageID,
+			&message.ThreadID,
+			&progression,
+		)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error fetching incident message data")
+			return messages, err
+		}
+
+		if progression != "" {
+			message.Progression = strings.Split(progression, ",")
+		}
+
+		messages = append(messages, &message)
+	}
+
+	return messages, nil
+}
+
+func (d *Database) UpsertIncidentMessage(chain string, message *types.IncidentMessage) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"INSERT INTO incident_messages (chain, reporter, destination, operator_address, incident_id, message_id, thread_id, progression) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) "+
+			"ON CONFLICT (chain, reporter, destination, operator_address) DO UPDATE SET incident_id = $5, message_id = $6, thread_id = $7, progression = $8",
+		chain,
+		message.Reporter,
+		message.Destination,
+		message.OperatorAddress,
+		message.IncidentID,
+		message.MessageID,
+		message.ThreadID,
+		strings.Join(message.Progression, ","),
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not save incident message")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) RemoveIncidentMessage(chain string, message *types.IncidentMessage) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"DELETE FROM incident_messages WHERE chain = $1 AND reporter = $2 AND destination = $3 AND operator_address = $4",
+		chain,
+		message.Reporter,
+		message.Destination,
+		message.OperatorAddress,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not delete incident message")
+		return err
+	}
+
+	return nil
+}
+
 func (d *Database) GetValueByKey(chain string, key string) ([]byte, error) {
 	d.MaybeMutexLock()
 	defer d.MaybeMutexUnlock()
@@ -359,3 +865,245 @@ func (d *Database) InsertEvent(chain string, height int64, entry types.ReportEve
 
 	return nil
 }
+
+type outboxEvent struct {
+	Type    constants.EventName `json:"type"`
+	Payload json.RawMessage     `json:"payload"`
+}
+
+func (d *Database) InsertOutboxEntry(
+	chain string,
+	reporter constants.ReporterName,
+	report *types.Report,
+	status constants.OutboxStatus,
+) error {
+	outboxEvents := make([]outboxEvent, len(report.Events))
+	for index, event := range report.Events {
+		eventBytes, err := json.Marshal(event)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Error marshaling outbox event")
+			return err
+		}
+
+		outboxEvents[index] = outboxEvent{Type: event.Type(), Payload: eventBytes}
+	}
+
+	payloadBytes, err := json.Marshal(outboxEvents)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error marshaling outbox payload")
+		return err
+	}
+
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	now := time.Now().Unix()
+
+	_, err = d.client.Exec(
+		"INSERT INTO outbox (chain, reporter, height, payload, status, created_at, next_attempt_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $6, $6)",
+		chain,
+		reporter,
+		report.Height,
+		payloadBytes,
+		status,
+		now,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Could not insert outbox entry")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) GetPendingOutboxEntries(chain string) ([]*types.OutboxEntry, error) {
+	return d.getOutboxEntries(chain, constants.OutboxStatusPending)
+}
+
+// GetDigestOutboxEntries returns the events put into the reporters' digests,
+// so the digests can be rebuilt after a restart.
+func (d *Database) GetDigestOutboxEntries(chain string) ([]*types.OutboxEntry, error) {
+	return d.getOutboxEntries(chain, constants.OutboxStatusDigest)
+}
+
+func (d *Database) getOutboxEntries(chain string, status constants.OutboxStatus) ([]*types.OutboxEntry, error) {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	entries := make([]*types.OutboxEntry, 0)
+	broken := make(map[int64]error)
+
+	rows, err := d.client.Query(
+		"SELECT id, reporter, height, payload, deliveries, attempts, created_at, next_attempt_at FROM outbox WHERE chain = $1 AND status = $2 ORDER BY id",
+		chain,
+		status,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Str("status", string(status)).Msg("Error getting outbox entries")
+		return entries, err
+	}
+	defer func() {
+		_ = rows.Close()
+		_ = rows.Err() // or modify return value
+	}()
+
+	for rows.Next() {
+		var (
+			id            int64
+			reporter      constants.ReporterName
+			height        int64
+			payload       []byte
+			deliveries    []byte
+			attempts      int
+			createdAt     int64
+			nextAttemptAt int64
+		)
+
+		if err := rows.Scan(&id, &reporter, &height, &payload, &deliveries, &attempts, &createdAt, &nextAttemptAt); err != nil {
+			d.logger.Error().Err(err).Msg("Error fetching outbox entry data")
+			return entries, err
+		}
+
+		report, err := decodeOutboxPayload(height, payload, deliveries)
+		if err != nil {
+			d.logger.Error().Err(err).Int64("id", id).Msg("Error decoding outbox entry, marking it as failed")
+			broken[id] = err
+			continue
+		}
+
+		entries = append(entries, &types.OutboxEntry{
+			ID:            id,
+			Reporter:      reporter,
+			Report:        report,
+			Attempts:      attempts,
+			CreatedAt:     time.Unix(createdAt, 0),
+			NextAttemptAt: time.Unix(nextAttemptAt, 0),
+		})
+	}
+
+	if err := rows.Close(); err != nil {
+		return entries, err
+	}
+
+	// An entry that cannot be decoded would fail the same way on every flush,
+	// so it's taken out of the queue instead of holding back the ones after it.
+	now := time.Now().Unix()
+	for id, decodeErr := range broken {
+		if _, err := d.client.Exec(
+			"UPDATE outbox SET status = $1, last_error = $2, updated_at = $3 WHERE id = $4",
+			constants.OutboxStatusFailed,
+			decodeErr.Error(),
+			now,
+			id,
+		); err != nil {
+			d.logger.Error().Err(err).Int64("id", id).Msg("Could not mark outbox entry as failed")
+		}
+	}
+
+	return entries, nil
+}
+
+func decodeOutboxPayload(height int64, payload []byte, deliveries []byte) (*types.Report, error) {
+	var outboxEvents []outboxEvent
+	if err := json.Unmarshal(payload, &outboxEvents); err != nil {
+		return nil, fmt.Errorf("error unmarshaling outbox payload: %s", err)
+	}
+
+	report := &types.Report{
+		Height: height,
+		Events: make([]types.ReportEvent, len(outboxEvents)),
+	}
+
+	if err := json.Unmarshal(deliveries, &report.Deliveries); err != nil {
+		return nil, fmt.Errorf("error unmarshaling outbox deliveries: %s", err)
+	}
+
+	for index, outboxEvent := range outboxEvents {
+		event, err := events.UnmarshalEvent(outboxEvent.Type, outboxEvent.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling outbox event: %s", err)
+		}
+
+		report.Events[index] = event
+	}
+
+	return report, nil
+}
+
+func (d *Database) UpdateOutboxEntry(
+	id int64,
+	status constants.OutboxStatus,
+	attempts int,
+	lastError string,
+	nextAttemptAt time.Time,
+	deliveries map[string]*types.ReportDelivery,
+) error {
+	if deliveries == nil {
+		deliveries = make(map[string]*types.ReportDelivery)
+	}
+
+	deliveriesBytes, err := json.Marshal(deliveries)
+	if err != nil {
+		d.logger.Error().Err(err).Int64("id", id).Msg("Error marshaling outbox deliveries")
+		return err
+	}
+
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err = d.client.Exec(
+		"UPDATE outbox SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4, updated_at = $5, deliveries = $6 WHERE id = $7",
+		status,
+		attempts,
+		lastError,
+		nextAttemptAt.Unix(),
+		time.Now().Unix(),
+		deliveriesBytes,
+		id,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Int64("id", id).Msg("Could not update outbox entry")
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDigestOutboxEntries removes the events of the reporter's digest
+// once the digest itself is put into the outbox.
+func (d *Database) DeleteDigestOutboxEntries(chain string, reporter constants.ReporterName) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"DELETE FROM outbox WHERE chain = $1 AND reporter = $2 AND status = $3",
+		chain,
+		reporter,
+		constants.OutboxStatusDigest,
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Str("reporter", string(reporter)).Msg("Error deleting digest outbox entries")
+		return err
+	}
+
+	return nil
+}
+
+func (d *Database) TrimOutboxBefore(chain string, before time.Time) error {
+	d.MaybeMutexLock()
+	defer d.MaybeMutexUnlock()
+
+	_, err := d.client.Exec(
+		"DELETE FROM outbox WHERE chain = $1 AND status != $2 AND status != $3 AND updated_at < $4",
+		chain,
+		constants.OutboxStatusPending,
+		constants.OutboxStatusDigest,
+		before.Unix(),
+	)
+	if err != nil {
+		d.logger.Error().Err(err).Msg("Error trimming outbox")
+		return err
+	}
+
+	return nil
+}
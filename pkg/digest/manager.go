@@ -0,0 +1,169 @@
+package digest
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/types"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type buffer struct {
+	StartedAt time.Time
+	Height    int64
+	Events    []types.ReportEvent
+}
+
+type Manager struct {
+	logger  zerolog.Logger
+	configs configPkg.DigestConfigs
+	buffers map[constants.ReporterName]*buffer
+	mutex   sync.Mutex
+}
+
+func NewManager(logger zerolog.Logger, chainConfig *configPkg.ChainConfig) *Manager {
+	return &Manager{
+		logger:  logger.With().Str("component", "digest_manager").Logger(),
+		configs: chainConfig.DigestConfigs,
+		buffers: make(map[constants.ReporterName]*buffer),
+	}
+}
+
+// Add puts the report events into the reporter's digest and returns
+// the events that should be sent right away and the ones that were put into the digest.
+func (m *Manager) Add(reporterName constants.ReporterName, report *types.Report) (*types.Report, *types.Report) {
+	digestConfig, ok := m.configs[reporterName]
+	if !ok {
+		return report, &types.Report{Height: report.Height}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	bypassed := make([]types.ReportEvent, 0)
+	buffered := make([]types.ReportEvent, 0)
+
+	for _, event := range report.Events {
+		if digestConfig.ShouldBypass(event) {
+			bypassed = append(bypassed, event)
+			continue
+		}
+
+		m.add(reporterName, time.Now(), report.Height, event)
+		buffered = append(buffered, event)
+	}
+
+	return &types.Report{Height: report.Height, Events: bypassed},
+		&types.Report{Height: report.Height, Events: buffered}
+}
+
+// Restore puts the events that were stored in the reporter's digest before the restart back
+// into it, so the digest window goes on from the time they were first added.
+func (m *Manager) Restore(reporterName constants.ReporterName, addedAt time.Time, report *types.Report) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, event := range report.Events {
+		m.add(reporterName, addedAt, report.Height, event)
+	}
+}
+
+func (m *Manager) add(reporterName constants.ReporterName, now time.Time, height int64, event types.ReportEvent) {
+	reporterBuffer, exists := m.buffers[reporterName]
+	if !exists {
+		reporterBuffer = &buffer{StartedAt: now}
+		m.buffers[reporterName] = reporterBuffer
+	}
+
+	if height > reporterBuffer.Height {
+		reporterBuffer.Height = height
+	}
+
+	reporterBuffer.Events = append(reporterBuffer.Events, event)
+}
+
+// PopDue returns merged digests whose window has passed, removing them from the buffer.
+func (m *Manager) PopDue(now time.Time) map[constants.ReporterName]*types.Report {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	reports := make(map[constants.ReporterName]*types.Report)
+
+	for reporterName, reporterBuffer := range m.buffers {
+		// a digest restored for a reporter that has no digest configured anymore
+		// is sent right away
+		digestConfig, ok := m.configs[reporterName]
+		if ok && now.Sub(reporterBuffer.StartedAt) < digestConfig.Interval*time.Second {
+			continue
+		}
+
+		delete(m.buffers, reporterName)
+
+		merged := MergeEvents(reporterBuffer.Events)
+
+		m.logger.Debug().
+			Str("name", string(reporterName)).
+			Int("events", len(reporterBuffer.Events)).
+			Int("merged", len(merged)).
+			Msg("Digest window passed")
+
+		reports[reporterName] = &types.Report{Height: reporterBuffer.Height, Events: merged}
+	}
+
+	return reports
+}
+
+// MergeEvents merges all group changes of a validator into one, going from
+// the group before the first change to the group after the last one,
+// and drops it if the validator ended up in the group it started in.
+func MergeEvents(reportEvents []types.ReportEvent) []types.ReportEvent {
+	firstGroupChanges := make(map[string]events.ValidatorGroupChanged)
+	lastGroupChangeIndexes := make(map[string]int)
+
+	for index, event := range reportEvents {
+		groupChanged, ok := event.(events.ValidatorGroupChanged)
+		if !ok {
+			continue
+		}
+
+		operatorAddress := groupChanged.Validator.OperatorAddress
+		if _, exists := firstGroupChanges[operatorAddress]; !exists {
+			firstGroupChanges[operatorAddress] = groupChanged
+		}
+
+		lastGroupChangeIndexes[operatorAddress] = index
+	}
+
+	merged := make([]types.ReportEvent, 0)
+
+	for index, event := range reportEvents {
+		groupChanged, ok := event.(events.ValidatorGroupChanged)
+		if !ok {
+			merged = append(merged, event)
+			continue
+		}
+
+		operatorAddress := groupChanged.Validator.OperatorAddress
+		if lastGroupChangeIndexes[operatorAddress] != index {
+			continue
+		}
+
+		first := firstGroupChanges[operatorAddress]
+		if first.MissedBlocksGroupBefore.Start == groupChanged.MissedBlocksGroupAfter.Start {
+			continue
+		}
+
+		merged = append(merged, events.ValidatorGroupChanged{
+			Validator:               groupChanged.Validator,
+			MissedBlocksBefore:      first.MissedBlocksBefore,
+			MissedBlocksAfter:       groupChanged.MissedBlocksAfter,
+			MissedBlocksGroupBefore: first.MissedBlocksGroupBefore,
+			MissedBlocksGroupAfter:  groupChanged.MissedBlocksGroupAfter,
+		})
+	}
+
+	return merged
+}
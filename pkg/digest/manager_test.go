@@ -0,0 +1,176 @@
+package digest
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func groupChanged(
+	validator *types.Validator,
+	before int64,
+	after int64,
+) events.ValidatorGroupChanged {
+	return events.ValidatorGroupChanged{
+		Validator:               validator,
+		MissedBlocksBefore:      before,
+		MissedBlocksAfter:       after,
+		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{Start: before / 100 * 100, End: before/100*100 + 99},
+		MissedBlocksGroupAfter:  &configPkg.MissedBlocksGroup{Start: after / 100 * 100, End: after/100*100 + 99},
+	}
+}
+
+func TestMergeEventsGroupChanges(t *testing.T) {
+	t.Parallel()
+
+	validator := &types.Validator{OperatorAddress: "validator"}
+	otherValidator := &types.Validator{OperatorAddress: "other"}
+
+	merged := MergeEvents([]types.ReportEvent{
+		groupChanged(validator, 50, 150),
+		groupChanged(otherValidator, 50, 150),
+		groupChanged(validator, 150, 250),
+		events.ValidatorJailed{Validator: otherValidator},
+	})
+
+	require.Len(t, merged, 3)
+
+	otherChanged, ok := merged[0].(events.ValidatorGroupChanged)
+	require.True(t, ok)
+	require.Equal(t, "other", otherChanged.Validator.OperatorAddress)
+
+	validatorChanged, ok := merged[1].(events.ValidatorGroupChanged)
+	require.True(t, ok)
+	require.Equal(t, "validator", validatorChanged.Validator.OperatorAddress)
+	require.Equal(t, int64(50), validatorChanged.MissedBlocksBefore)
+	require.Equal(t, int64(250), validatorChanged.MissedBlocksAfter)
+	require.Equal(t, int64(0), validatorChanged.MissedBlocksGroupBefore.Start)
+	require.Equal(t, int64(200), validatorChanged.MissedBlocksGroupAfter.Start)
+
+	require.Equal(t, constants.EventValidatorJailed, merged[2].Type())
+}
+
+func TestMergeEventsGroupChangesCancelOut(t *testing.T) {
+	t.Parallel()
+
+	validator := &types.Validator{OperatorAddress: "validator"}
+
+	merged := MergeEvents([]types.ReportEvent{
+		groupChanged(validator, 50, 150),
+		groupChanged(validator, 150, 50),
+	})
+
+	require.Empty(t, merged)
+}
+
+func TestManagerNoDigest(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(zerolog.Nop(), &configPkg.ChainConfig{})
+	report := &types.Report{
+		Height: 10,
+		Events: []types.ReportEvent{events.ValidatorJailed{Validator: &types.Validator{}}},
+	}
+
+	immediate, buffered := manager.Add(constants.TelegramReporterName, report)
+	require.Equal(t, report, immediate)
+	require.True(t, buffered.Empty())
+	require.Empty(t, manager.PopDue(time.Now()))
+}
+
+func TestManagerDigest(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(zerolog.Nop(), &configPkg.ChainConfig{
+		DigestConfigs: configPkg.DigestConfigs{
+			constants.TelegramReporterName: &configPkg.DigestConfig{
+				Interval:     60,
+				BypassEvents: []string{string(constants.EventValidatorJailed)},
+			},
+		},
+	})
+
+	validator := &types.Validator{OperatorAddress: "validator"}
+
+	immediate, buffered := manager.Add(constants.TelegramReporterName, &types.Report{
+		Height: 10,
+		Events: []types.ReportEvent{
+			groupChanged(validator, 50, 150),
+			events.ValidatorJailed{Validator: validator},
+		},
+	})
+	require.Len(t, immediate.Events, 1)
+	require.Equal(t, constants.EventValidatorJailed, immediate.Events[0].Type())
+	require.Len(t, buffered.Events, 1)
+	require.Equal(t, constants.EventValidatorGroupChanged, buffered.Events[0].Type())
+
+	immediate, buffered = manager.Add(constants.TelegramReporterName, &types.Report{
+		Height: 11,
+		Events: []types.ReportEvent{groupChanged(validator, 150, 250)},
+	})
+	require.True(t, immediate.Empty())
+	require.Len(t, buffered.Events, 1)
+
+	require.Empty(t, manager.PopDue(time.Now()))
+
+	reports := manager.PopDue(time.Now().Add(time.Minute))
+	require.Len(t, reports, 1)
+
+	report := reports[constants.TelegramReporterName]
+	require.Equal(t, int64(11), report.Height)
+	require.Len(t, report.Events, 1)
+
+	require.Empty(t, manager.PopDue(time.Now().Add(time.Minute)))
+}
+
+func TestManagerRestore(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(zerolog.Nop(), &configPkg.ChainConfig{
+		DigestConfigs: configPkg.DigestConfigs{
+			constants.TelegramReporterName: &configPkg.DigestConfig{Interval: 60},
+		},
+	})
+
+	validator := &types.Validator{OperatorAddress: "validator"}
+	addedAt := time.Now().Add(-30 * time.Second)
+
+	manager.Restore(constants.TelegramReporterName, addedAt, &types.Report{
+		Height: 10,
+		Events: []types.ReportEvent{groupChanged(validator, 50, 150)},
+	})
+	manager.Restore(constants.TelegramReporterName, addedAt.Add(time.Second), &types.Report{
+		Height: 11,
+		Events: []types.ReportEvent{groupChanged(validator, 150, 250)},
+	})
+
+	require.Empty(t, manager.PopDue(addedAt.Add(59*time.Second)))
+
+	reports := manager.PopDue(addedAt.Add(time.Minute))
+	require.Len(t, reports, 1)
+
+	report := reports[constants.TelegramReporterName]
+	require.Equal(t, int64(11), report.Height)
+	require.Len(t, report.Events, 1)
+}
+
+func TestManagerRestoreWithoutDigest(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(zerolog.Nop(), &configPkg.ChainConfig{})
+
+	manager.Restore(constants.TelegramReporterName, time.Now(), &types.Report{
+		Height: 10,
+		Events: []types.ReportEvent{events.ValidatorJailed{Validator: &types.Validator{}}},
+	})
+
+	reports := manager.PopDue(time.Now())
+	require.Len(t, reports, 1)
+	require.Len(t, reports[constants.TelegramReporterName].Events, 1)
+}
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+)
+
+func UnmarshalEvent(eventType constants.EventName, payload []byte) (types.ReportEvent, error) {
+	switch eventType {
+	case constants.EventValidatorActive:
+		return unmarshalEvent[ValidatorActive](payload)
+	case constants.EventValidatorGroupChanged:
+		return unmarshalEvent[ValidatorGroupChanged](payload)
+	case constants.EventValidatorInactive:
+		return unmarshalEvent[ValidatorInactive](payload)
+	case constants.EventValidatorJailed:
+		return unmarshalEvent[ValidatorJailed](payload)
+	case constants.EventValidatorUnjailed:
+		return unmarshalEvent[ValidatorUnjailed](payload)
+	case constants.EventValidatorTombstoned:
+		return unmarshalEvent[ValidatorTombstoned](payload)
+	case constants.EventValidatorCreated:
+		return unmarshalEvent[ValidatorCreated](payload)
+	case constants.EventValidatorJoinedSignatory:
+		return unmarshalEvent[ValidatorJoinedSignatory](payload)
+	case constants.EventValidatorLeftSignatory:
+		return unmarshalEvent[ValidatorLeftSignatory](payload)
+	case constants.EventValidatorChangedKey:
+		return unmarshalEvent[ValidatorChangedKey](payload)
+	case constants.EventValidatorChangedMoniker:
+		return unmarshalEvent[ValidatorChangedMoniker](payload)
+	case constants.EventValidatorChangedCommission:
+		return unmarshalEvent[ValidatorChangedCommission](payload)
+	case constants.EventValidatorMaintenanceOver:
+		return unmarshalEvent[ValidatorMaintenanceOver](payload)
+	case constants.EventValidatorIncidentReminder:
+		return unmarshalEvent[ValidatorIncidentReminder](payload)
+	default:
+		return nil, fmt.Errorf("unknown event type: %s", eventType)
+	}
+}
+
+func unmarshalEvent[T types.ReportEvent](payload []byte) (types.ReportEvent, error) {
+	var event T
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,63 @@
+package events_test
+
+import (
+	"encoding/json"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEventUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := events.UnmarshalEvent("unknown", []byte("{}"))
+	require.Error(t, err)
+}
+
+func TestUnmarshalEventInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	_, err := events.UnmarshalEvent(constants.EventValidatorJailed, []byte("invalid"))
+	require.Error(t, err)
+}
+
+func TestUnmarshalEventAllTypes(t *testing.T) {
+	t.Parallel()
+
+	for _, eventName := range constants.GetEventNames() {
+		event, err := events.UnmarshalEvent(eventName, []byte("{}"))
+		require.NoError(t, err)
+		require.Equal(t, eventName, event.Type())
+	}
+}
+
+func TestUnmarshalEventRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorGroupChanged{
+		Validator:               &types.Validator{Moniker: "test", OperatorAddress: "address"},
+		MissedBlocksBefore:      10,
+		MissedBlocksAfter:       200,
+		MissedBlocksGroupBefore: &config.MissedBlocksGroup{Start: 0, End: 99},
+		MissedBlocksGroupAfter:  &config.MissedBlocksGroup{Start: 200, End: 299},
+	}
+
+	payload, err := json.Marshal(entry)
+	require.NoError(t, err)
+
+	event, err := events.UnmarshalEvent(entry.Type(), payload)
+	require.NoError(t, err)
+
+	unmarshaled, ok := event.(events.ValidatorGroupChanged)
+	require.True(t, ok)
+	require.Equal(t, "address", unmarshaled.Validator.OperatorAddress)
+	require.Equal(t, int64(10), unmarshaled.MissedBlocksBefore)
+	require.Equal(t, int64(200), unmarshaled.MissedBlocksAfter)
+	require.Equal(t, entry.MissedBlocksGroupBefore, unmarshaled.MissedBlocksGroupBefore)
+	require.Equal(t, entry.MissedBlocksGroupAfter, unmarshaled.MissedBlocksGroupAfter)
+	require.True(t, unmarshaled.IsIncreasing())
+}
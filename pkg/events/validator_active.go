@@ -1,7 +1,6 @@
 package events
 
 import (
-	"fmt"
 	"main/pkg/constants"
 	"main/pkg/types"
 )
@@ -17,22 +16,3 @@ func (e ValidatorActive) Type() constants.EventName {
 func (e ValidatorActive) GetValidator() *types.Validator {
 	return e.Validator
 }
-
-func (e ValidatorActive) Render(formatType constants.FormatType, renderData types.ReportEventRenderData) string {
-	switch formatType {
-	case constants.FormatTypeMarkdown:
-		return fmt.Sprintf(
-			"✅ **%s has joined the active set** %s",
-			renderData.ValidatorLink,
-			renderData.Notifiers,
-		)
-	case constants.FormatTypeHTML:
-		return fmt.Sprintf(
-			"✅ <strong>%s has joined the active set</strong> %s",
-			renderData.ValidatorLink,
-			renderData.Notifiers,
-		)
-	default:
-		return fmt.Sprintf("Unsupported format type: %s", formatType)
-	}
-}
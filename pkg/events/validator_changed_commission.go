@@ -1,7 +1,6 @@
 package events
 
 import (
-	"fmt"
 	"main/pkg/constants"
 	"main/pkg/types"
 )
@@ -18,26 +17,3 @@ func (e ValidatorChangedCommission) Type() constants.EventName {
 func (e ValidatorChangedCommission) GetValidator() *types.Validator {
 	return e.Validator
 }
-
-func (e ValidatorChangedCommission) Render(formatType constants.FormatType, renderData types.ReportEventRenderData) string {
-	switch formatType {
-	case constants.FormatTypeMarkdown:
-		return fmt.Sprintf(
-			"**💰️ %s has changed its commission**: %.2f%% -> %.2f%% %s",
-			renderData.ValidatorLink,
-			e.OldValidator.Commission*100,
-			e.Validator.Commission*100,
-			renderData.Notifiers,
-		)
-	case constants.FormatTypeHTML:
-		return fmt.Sprintf(
-			"<strong>💰️ %s has changed its commission</strong>: %.2f%% -> %.2f%% %s",
-			renderData.ValidatorLink,
-			e.OldValidator.Commission*100,
-			e.Validator.Commission*100,
-			renderData.Notifiers,
-		)
-	default:
-		return fmt.Sprintf("Unsupported format type: %s", formatType)
-	}
-}
@@ -1,7 +1,6 @@
 package events
 
 import (
-	"fmt"
 	"main/pkg/constants"
 	"main/pkg/types"
 )
@@ -18,22 +17,3 @@ func (e ValidatorChangedKey) Type() constants.EventName {
 func (e ValidatorChangedKey) GetValidator() *types.Validator {
 	return e.Validator
 }
-
-func (e ValidatorChangedKey) Render(formatType constants.FormatType, renderData types.ReportEventRenderData) string {
-	switch formatType {
-	case constants.FormatTypeMarkdown:
-		return fmt.Sprintf(
-			"**↔️ %s has changed its signing key** %s",
-			renderData.ValidatorLink,
-			renderData.Notifiers,
-		)
-	case constants.FormatTypeHTML:
-		return fmt.Sprintf(
-			"<strong>↔️ %s has changed its signing key</strong> %s",
-			renderData.ValidatorLink,
-			renderData.Notifiers,
-		)
-	default:
-		return fmt.Sprintf("Unsupported format type: %s", formatType)
-	}
-}
@@ -1,7 +1,6 @@
 package events
 
 import (
-	"fmt"
 	"main/pkg/constants"
 	"main/pkg/types"
 )
@@ -18,24 +17,3 @@ func (e ValidatorChangedMoniker) Type() constants.EventName {
 func (e ValidatorChangedMoniker) GetValidator() *types.Validator {
 	return e.Validator
 }
-
-func (e ValidatorChangedMoniker) Render(formatType constants.FormatType, renderData types.ReportEventRenderData) string {
-	switch formatType {
-	case constants.FormatTypeMarkdown:
-		return fmt.Sprintf(
-			"**✍️ %s has changed its moniker** (was \"%s\") %s",
-			renderData.ValidatorLink,
-			e.OldValidator.Moniker,
-			renderData.Notifiers,
-		)
-	case constants.FormatTypeHTML:
-		return fmt.Sprintf(
-			"<strong>✍️ %s has changed its moniker</strong> (was \"%s\") %s",
-			renderData.ValidatorLink,
-			e.OldValidator.Moniker,
-			renderData.Notifiers,
-		)
-	default:
-		return fmt.Sprintf("Unsupported format type: %s", formatType)
-	}
-}
@@ -1,8 +1,6 @@
 package events
 
 import (
-	"fmt"
-	"html"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
 	"main/pkg/types"
@@ -44,32 +42,12 @@ func (e ValidatorGroupChanged) IsIncreasing() bool {
 	return e.MissedBlocksGroupBefore.Start < e.MissedBlocksGroupAfter.Start
 }
 
-func (e ValidatorGroupChanged) GetValidator() *types.Validator {
-	return e.Validator
+// GetMissedBlocks returns the higher of the missed blocks counters before and after
+// the change, so recovering from a group is reported to those who got notified about it.
+func (e ValidatorGroupChanged) GetMissedBlocks() int64 {
+	return max(e.MissedBlocksBefore, e.MissedBlocksAfter)
 }
 
-func (e ValidatorGroupChanged) Render(formatType constants.FormatType, renderData types.ReportEventRenderData) string {
-	// a string like "🟡 <validator> (link) is skipping blocks (> 1.0%)  (XXX till jail) <notifier> <notifier2>"
-	switch formatType {
-	case constants.FormatTypeMarkdown:
-		return fmt.Sprintf(
-			"**%s %s %s**%s %s",
-			e.GetEmoji(),
-			renderData.ValidatorLink,
-			e.GetDescription(),
-			renderData.TimeToJail,
-			renderData.Notifiers,
-		)
-	case constants.FormatTypeHTML:
-		return fmt.Sprintf(
-			"<strong>%s %s %s</strong>%s %s",
-			e.GetEmoji(),
-			renderData.ValidatorLink,
-			html.EscapeString(e.GetDescription()),
-			renderData.TimeToJail,
-			renderData.Notifiers,
-		)
-	default:
-		return fmt.Sprintf("Unsupported format type: %s", formatType)
-	}
+func (e ValidatorGroupChanged) GetValidator() *types.Validator {
+	return e.Validator
 }
@@ -19,6 +19,18 @@ func TestValidatorGroupChangedBase(t *testing.T) {
 	assert.Equal(t, "test", entry.GetValidator().Moniker)
 }
 
+func TestValidatorGroupChangedGetMissedBlocks(t *testing.T) {
+	t.Parallel()
+
+	increasing := events.ValidatorGroupChanged{MissedBlocksBefore: 10, MissedBlocksAfter: 100}
+	assert.Equal(t, int64(100), increasing.GetMissedBlocks())
+
+	decreasing := events.ValidatorGroupChanged{MissedBlocksBefore: 100, MissedBlocksAfter: 10}
+	assert.Equal(t, int64(100), decreasing.GetMissedBlocks())
+
+	var _ types.MissedBlocksEvent = increasing
+}
+
 func TestValidatorGetDescriptionAndEmojiIncreasing(t *testing.T) {
 	t.Parallel()
 
@@ -72,88 +84,3 @@ func TestValidatorGetDescriptionAndEmojiDecreasing(t *testing.T) {
 	assert.Equal(t, "end1", entry.GetDescription())
 	assert.Equal(t, "emojiend1", entry.GetEmoji())
 }
-
-func TestValidatorGroupChangedFormatHTML(t *testing.T) {
-	t.Parallel()
-
-	entry := events.ValidatorGroupChanged{
-		Validator: &types.Validator{Moniker: "test"},
-		MissedBlocksGroupAfter: &configPkg.MissedBlocksGroup{
-			Start:      0,
-			End:        5,
-			DescStart:  "start1",
-			DescEnd:    "end1",
-			EmojiStart: "emojistart1",
-			EmojiEnd:   "emojiend1",
-		},
-		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{
-			Start:      6,
-			End:        10,
-			DescStart:  "start2",
-			DescEnd:    "end2",
-			EmojiStart: "emojistart2",
-			EmojiEnd:   "emojiend2",
-		},
-	}
-
-	renderData := types.ReportEventRenderData{
-		Notifiers:     "notifier1 notifier2",
-		ValidatorLink: "<link>",
-		TimeToJail:    "<jail>",
-	}
-	rendered := entry.Render(constants.FormatTypeHTML, renderData)
-	assert.Equal(
-		t,
-		"<strong>emojiend1 <link> end1</strong><jail> notifier1 notifier2",
-		rendered,
-	)
-}
-
-func TestValidatorGroupChangedFormatMarkdown(t *testing.T) {
-	t.Parallel()
-
-	entry := events.ValidatorGroupChanged{
-		Validator: &types.Validator{Moniker: "test"},
-		MissedBlocksGroupAfter: &configPkg.MissedBlocksGroup{
-			Start:      0,
-			End:        5,
-			DescStart:  "start1",
-			DescEnd:    "end1",
-			EmojiStart: "emojistart1",
-			EmojiEnd:   "emojiend1",
-		},
-		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{
-			Start:      6,
-			End:        10,
-			DescStart:  "start2",
-			DescEnd:    "end2",
-			EmojiStart: "emojistart2",
-			EmojiEnd:   "emojiend2",
-		},
-	}
-
-	renderData := types.ReportEventRenderData{
-		Notifiers:     "notifier1 notifier2",
-		ValidatorLink: "<link>",
-		TimeToJail:    "<jail>",
-	}
-	rendered := entry.Render(constants.FormatTypeMarkdown, renderData)
-	assert.Equal(
-		t,
-		"**emojiend1 <link> end1**<jail> notifier1 notifier2",
-		rendered,
-	)
-}
-
-func TestValidatorGroupChangedFormatUnsupported(t *testing.T) {
-	t.Parallel()
-
-	entry := events.ValidatorGroupChanged{Validator: &types.Validator{Moniker: "test"}}
-	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
-	rendered := entry.Render(constants.FormatTypeTest, renderData)
-	assert.Equal(
-		t,
-		"Unsupported format type: test",
-		rendered,
-	)
-}
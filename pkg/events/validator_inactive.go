@@ -1,7 +1,6 @@
 package events
 
 import (
-	"fmt"
 	"main/pkg/constants"
 	"main/pkg/types"
 )
@@ -17,22 +16,3 @@ func (e ValidatorInactive) Type() constants.EventName {
 func (e ValidatorInactive) GetValidator() *types.Validator {
 	return e.Validator
 }
-
-func (e ValidatorInactive) Render(formatType constants.FormatType, renderData types.ReportEventRenderData) string {
-	switch formatType {
-	case constants.FormatTypeMarkdown:
-		return fmt.Sprintf(
-			"😔 **%s has left the active set** %s",
-			renderData.ValidatorLink,
-			renderData.Notifiers,
-		)
-	case constants.FormatTypeHTML:
-		return fmt.Sprintf(
-			"😔 <strong>%s has left the active set</strong> %s",
-			renderData.ValidatorLink,
-			renderData.Notifiers,
-		)
-	default:
-		return fmt.Sprintf("Unsupported format type: %s", formatType)
-	}
-}
@@ -0,0 +1,39 @@
+package events
+
+import (
+	"fmt"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"time"
+)
+
+type ValidatorIncidentReminder struct {
+	Validator         *types.Validator
+	MissedBlocks      int64
+	MissedBlocksGroup *configPkg.MissedBlocksGroup
+	Duration          time.Duration
+	TimeToJail        time.Duration
+}
+
+func (e ValidatorIncidentReminder) Type() constants.EventName {
+	return constants.EventValidatorIncidentReminder
+}
+
+func (e ValidatorIncidentReminder) GetValidator() *types.Validator {
+	return e.Validator
+}
+
+func (e ValidatorIncidentReminder) GetMissedBlocks() int64 {
+	return e.MissedBlocks
+}
+
+func (e ValidatorIncidentReminder) GetDescription() string {
+	return fmt.Sprintf(
+		"%s for %s (%s till jail)",
+		e.MissedBlocksGroup.DescStart,
+		utils.FormatDuration(e.Duration),
+		utils.FormatDuration(e.TimeToJail),
+	)
+}
@@ -0,0 +1,23 @@
+package events_test
+
+import (
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorIncidentReminderBase(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorIncidentReminder{
+		Validator:    &types.Validator{Moniker: "test"},
+		MissedBlocks: 500,
+	}
+
+	assert.Equal(t, constants.EventValidatorIncidentReminder, entry.Type())
+	assert.Equal(t, "test", entry.GetValidator().Moniker)
+	assert.Equal(t, int64(500), entry.GetMissedBlocks())
+}
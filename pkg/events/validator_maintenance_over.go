@@ -0,0 +1,49 @@
+package events
+
+import (
+	"fmt"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/types"
+)
+
+type ValidatorMaintenanceOver struct {
+	Validator         *types.Validator
+	Window            *types.MaintenanceWindow
+	MissedBlocks      int64
+	MissedBlocksGroup *configPkg.MissedBlocksGroup
+	IsActive          bool
+	Recovered         bool
+}
+
+func (e ValidatorMaintenanceOver) Type() constants.EventName {
+	return constants.EventValidatorMaintenanceOver
+}
+
+func (e ValidatorMaintenanceOver) GetValidator() *types.Validator {
+	return e.Validator
+}
+
+func (e ValidatorMaintenanceOver) GetEmoji() string {
+	if e.Recovered {
+		return "✅"
+	}
+
+	return "⚠️"
+}
+
+func (e ValidatorMaintenanceOver) GetDescription() string {
+	if e.Recovered {
+		return fmt.Sprintf("has recovered (%d missed blocks)", e.MissedBlocks)
+	}
+
+	if e.Validator.Jailed {
+		return "has not recovered: it is jailed"
+	}
+
+	if !e.IsActive || e.MissedBlocksGroup == nil {
+		return "has not recovered: it is not in the active set"
+	}
+
+	return fmt.Sprintf("has not recovered: it %s (%d missed blocks)", e.MissedBlocksGroup.DescStart, e.MissedBlocks)
+}
@@ -0,0 +1,48 @@
+package events_test
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorMaintenanceOverBase(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorMaintenanceOver{Validator: &types.Validator{Moniker: "test"}}
+
+	assert.Equal(t, constants.EventValidatorMaintenanceOver, entry.Type())
+	assert.Equal(t, "test", entry.GetValidator().Moniker)
+}
+
+func TestValidatorMaintenanceOverGetDescription(t *testing.T) {
+	t.Parallel()
+
+	recovered := events.ValidatorMaintenanceOver{
+		Validator:    &types.Validator{},
+		MissedBlocks: 5,
+		IsActive:     true,
+		Recovered:    true,
+	}
+	assert.Equal(t, "has recovered (5 missed blocks)", recovered.GetDescription())
+	assert.Equal(t, "✅", recovered.GetEmoji())
+
+	jailed := events.ValidatorMaintenanceOver{Validator: &types.Validator{Jailed: true}}
+	assert.Equal(t, "has not recovered: it is jailed", jailed.GetDescription())
+	assert.Equal(t, "⚠️", jailed.GetEmoji())
+
+	inactive := events.ValidatorMaintenanceOver{Validator: &types.Validator{}}
+	assert.Equal(t, "has not recovered: it is not in the active set", inactive.GetDescription())
+
+	missing := events.ValidatorMaintenanceOver{
+		Validator:         &types.Validator{},
+		MissedBlocks:      500,
+		MissedBlocksGroup: &configPkg.MissedBlocksGroup{DescStart: "is skipping blocks (> 5%)"},
+		IsActive:          true,
+	}
+	assert.Equal(t, "has not recovered: it is skipping blocks (> 5%) (500 missed blocks)", missing.GetDescription())
+}
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"main/pkg/constants"
 	"main/pkg/metrics"
@@ -42,6 +44,21 @@ func (c *Client) GetInternal(
 	queryType constants.QueryType,
 	headers map[string]string,
 ) (io.ReadCloser, error) {
+	res, err := c.DoRequest(http.MethodGet, url, nil, queryType, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (c *Client) DoRequest(
+	method string,
+	url string,
+	body io.Reader,
+	queryType constants.QueryType,
+	headers map[string]string,
+) (*http.Response, error) {
 	var transport http.RoundTripper
 
 	transportRaw, ok := http.DefaultTransport.(*http.Transport)
@@ -66,7 +83,7 @@ func (c *Client) GetInternal(
 		QueryType: queryType,
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fullURL, nil)
+	req, err := http.NewRequest(method, fullURL, body)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +96,7 @@ func (c *Client) GetInternal(
 
 	c.logger.Trace().
 		Str("url", fullURL).
+		Str("method", method).
 		Msg("Doing a query...")
 
 	res, err := client.Do(req)
@@ -96,7 +114,7 @@ func (c *Client) GetInternal(
 	queryInfo.Success = true
 	c.metricsManager.LogQuery(c.chainName, queryInfo)
 
-	return res.Body, nil
+	return res, nil
 }
 
 func (c *Client) GetPlain(
@@ -136,3 +154,24 @@ func (c *Client) Get(
 
 	return body.Close()
 }
+
+func (c *Client) Post(
+	url string,
+	queryType constants.QueryType,
+	body []byte,
+	headers map[string]string,
+) error {
+	res, err := c.DoRequest(http.MethodPost, url, bytes.NewReader(body), queryType, headers)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		responseBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("got HTTP %d: %s", res.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
@@ -81,3 +81,49 @@ func TestHttpClientErrorReading(t *testing.T) {
 	_, err := client.GetPlain("/", constants.QueryTypeBlock, map[string]string{})
 	require.Error(t, err)
 }
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientPostErrorStatus(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(*logger, configPkg.MetricsConfig{Enabled: null.BoolFrom(false)})
+	client := NewClient(*logger, metricsManager, "http://example.com", "chain")
+
+	httpmock.RegisterResponder(
+		"POST",
+		"http://example.com/",
+		httpmock.NewBytesResponder(500, []byte("error")),
+	)
+
+	err := client.Post("/", constants.QueryTypeWebhook, []byte("{}"), map[string]string{})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "error")
+}
+
+//nolint:paralleltest // disabled due to httpmock usage
+func TestHttpClientPostOk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(*logger, configPkg.MetricsConfig{Enabled: null.BoolFrom(false)})
+	client := NewClient(*logger, metricsManager, "http://example.com", "chain")
+
+	httpmock.RegisterResponder(
+		"POST",
+		"http://example.com/",
+		func(request *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			require.Equal(t, "{}", string(body))
+			require.Equal(t, "value", request.Header.Get("X-Header"))
+
+			return httpmock.NewBytesResponse(200, []byte("ok")), nil
+		},
+	)
+
+	err := client.Post("/", constants.QueryTypeWebhook, []byte("{}"), map[string]string{"X-Header": "value"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,178 @@
+package i18n
+
+import (
+	"fmt"
+	"io/fs"
+	"main/locales"
+	"main/pkg/constants"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Catalog holds the translations of English messages into a language,
+// and the way durations and dates are formatted in it.
+type Catalog struct {
+	DateFormat string `toml:"date-format"`
+	// month names replacing the English ones the date format renders, full and abbreviated,
+	// from January to December
+	Months      []string            `toml:"months"`
+	ShortMonths []string            `toml:"short-months"`
+	Units       map[string][]string `toml:"units"`
+	Messages    map[string]string   `toml:"messages"`
+}
+
+var catalogs = MustLoadCatalogs(locales.LocalesFs)
+
+func LoadCatalogs(filesystem fs.FS) (map[constants.Language]*Catalog, error) {
+	loaded := make(map[constants.Language]*Catalog)
+
+	for _, language := range constants.GetLanguages() {
+		bytes, err := fs.ReadFile(filesystem, string(language)+".toml")
+		if err != nil {
+			return nil, fmt.Errorf("error loading %s catalog: %s", language, err)
+		}
+
+		catalog := &Catalog{}
+		if _, err := toml.Decode(string(bytes), catalog); err != nil {
+			return nil, fmt.Errorf("error parsing %s catalog: %s", language, err)
+		}
+
+		loaded[language] = catalog
+	}
+
+	return loaded, nil
+}
+
+func MustLoadCatalogs(filesystem fs.FS) map[constants.Language]*Catalog {
+	loaded, err := LoadCatalogs(filesystem)
+	if err != nil {
+		panic(err)
+	}
+
+	return loaded
+}
+
+type Localizer struct {
+	Language constants.Language
+	Catalog  *Catalog
+}
+
+// GetLocalizer returns the localizer for the language, falling back to English
+// if the language is not set or not supported.
+func GetLocalizer(language constants.Language) *Localizer {
+	catalog, ok := catalogs[language]
+	if !ok {
+		language = constants.LanguageEnglish
+		catalog = catalogs[language]
+	}
+
+	return &Localizer{Language: language, Catalog: catalog}
+}
+
+// Translate returns the message translated into the localizer language, or the message
+// itself if it has no translation, formatted with the arguments if there are any.
+func (l *Localizer) Translate(message string, args ...any) string {
+	if translated, ok := l.Catalog.Messages[message]; ok && translated != "" {
+		message = translated
+	}
+
+	if len(args) == 0 {
+		return message
+	}
+
+	return fmt.Sprintf(message, args...)
+}
+
+// FormatDuration works like utils.FormatDuration, but with the units in the localizer language.
+func (l *Localizer) FormatDuration(duration time.Duration) string {
+	days := int64(duration.Hours() / 24)
+	hours := int64(math.Mod(duration.Hours(), 24))
+	minutes := int64(math.Mod(duration.Minutes(), 60))
+	seconds := int64(math.Mod(duration.Seconds(), 60))
+
+	chunks := []struct {
+		unit   string
+		amount int64
+	}{
+		{"day", days},
+		{"hour", hours},
+		{"minute", minutes},
+		{"second", seconds},
+	}
+
+	parts := []string{}
+
+	for _, chunk := range chunks {
+		if chunk.amount == 0 {
+			continue
+		}
+
+		parts = append(parts, fmt.Sprintf("%d %s", chunk.amount, l.GetUnit(chunk.unit, chunk.amount)))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (l *Localizer) GetUnit(unit string, amount int64) string {
+	forms := l.Catalog.Units[unit]
+	if len(forms) == 0 {
+		return unit
+	}
+
+	form := GetPluralForm(l.Language, amount)
+	if form >= len(forms) {
+		form = len(forms) - 1
+	}
+
+	return forms[form]
+}
+
+// FormatDate formats the date with the catalog date format, replacing the English
+// month names in it with the ones from the catalog, if it has any.
+func (l *Localizer) FormatDate(date time.Time) string {
+	layout := l.Catalog.DateFormat
+	if layout == "" {
+		layout = time.RFC822
+	}
+
+	formatted := date.Format(layout)
+	month := int(date.Month()) - 1
+
+	// the full month name is replaced first, as the abbreviated one is its prefix
+	if len(l.Catalog.Months) == 12 {
+		formatted = strings.ReplaceAll(formatted, date.Month().String(), l.Catalog.Months[month])
+	}
+
+	if len(l.Catalog.ShortMonths) == 12 {
+		formatted = strings.ReplaceAll(formatted, date.Format("Jan"), l.Catalog.ShortMonths[month])
+	}
+
+	return formatted
+}
+
+// GetPluralForm returns the index of the plural form to use for the amount
+// among the forms the language catalog defines for each unit.
+func GetPluralForm(language constants.Language, amount int64) int {
+	switch language {
+	case constants.LanguageChinese:
+		return 0
+	case constants.LanguageRussian:
+		switch {
+		case amount%10 == 1 && amount%100 != 11:
+			return 0
+		case amount%10 >= 2 && amount%10 <= 4 && (amount%100 < 12 || amount%100 > 14):
+			return 1
+		default:
+			return 2
+		}
+	default:
+		if amount == 1 {
+			return 0
+		}
+
+		return 1
+	}
+}
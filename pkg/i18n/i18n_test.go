@@ -0,0 +1,178 @@
+package i18n_test
+
+import (
+	"io/fs"
+	"main/locales"
+	"main/pkg/constants"
+	"main/pkg/i18n"
+	"main/pkg/utils"
+	"main/templates"
+	"regexp"
+	"strconv"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCatalogsOk(t *testing.T) {
+	t.Parallel()
+
+	catalogs, err := i18n.LoadCatalogs(locales.LocalesFs)
+	require.NoError(t, err)
+	assert.Len(t, catalogs, len(constants.GetLanguages()))
+}
+
+func TestLoadCatalogsMissing(t *testing.T) {
+	t.Parallel()
+
+	_, err := i18n.LoadCatalogs(fstest.MapFS{
+		"en.toml": &fstest.MapFile{Data: []byte("date-format = \"02 Jan\"")},
+	})
+	require.Error(t, err)
+}
+
+func TestLoadCatalogsInvalid(t *testing.T) {
+	t.Parallel()
+
+	filesystem := fstest.MapFS{}
+	for _, language := range constants.GetLanguages() {
+		filesystem[string(language)+".toml"] = &fstest.MapFile{Data: []byte("date-format = ")}
+	}
+
+	_, err := i18n.LoadCatalogs(filesystem)
+	require.Error(t, err)
+}
+
+func TestGetLocalizerFallback(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, constants.LanguageEnglish, i18n.GetLocalizer("").Language)
+	assert.Equal(t, constants.LanguageEnglish, i18n.GetLocalizer("xx").Language)
+	assert.Equal(t, constants.LanguageRussian, i18n.GetLocalizer(constants.LanguageRussian).Language)
+}
+
+func TestTranslate(t *testing.T) {
+	t.Parallel()
+
+	localizer := i18n.GetLocalizer(constants.LanguageRussian)
+	assert.Equal(t, "Инцидент завершён", localizer.Translate("Incident is over"))
+	assert.Equal(t, "Язык в chain изменён на ru.", localizer.Translate("Language on %s is set to %s.", "chain", "ru"))
+	assert.Equal(t, "unknown %s", localizer.Translate("unknown %s"))
+	assert.Equal(t, "unknown test", localizer.Translate("unknown %s", "test"))
+}
+
+func TestTranslateReordered(t *testing.T) {
+	t.Parallel()
+
+	localizer := i18n.GetLocalizer(constants.LanguageChinese)
+	translated := localizer.Translate("%s: %d validator event(s) at height %d", "chain", 5, 100)
+	assert.Equal(t, "chain：高度 100 的 5 个验证人事件", translated)
+}
+
+func TestFormatDurationEnglish(t *testing.T) {
+	t.Parallel()
+
+	localizer := i18n.GetLocalizer(constants.LanguageEnglish)
+	for _, duration := range []time.Duration{
+		time.Second,
+		2 * time.Minute,
+		25*time.Hour + 3*time.Second,
+		49*time.Hour + time.Minute,
+	} {
+		assert.Equal(t, utils.FormatDuration(duration), localizer.FormatDuration(duration))
+	}
+}
+
+func TestFormatDurationRussian(t *testing.T) {
+	t.Parallel()
+
+	localizer := i18n.GetLocalizer(constants.LanguageRussian)
+	assert.Equal(t, "1 день 2 часа", localizer.FormatDuration(26*time.Hour))
+	assert.Equal(t, "5 минут 21 секунда", localizer.FormatDuration(5*time.Minute+21*time.Second))
+	assert.Equal(t, "11 часов", localizer.FormatDuration(11*time.Hour))
+}
+
+func TestGetPluralForm(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, i18n.GetPluralForm(constants.LanguageEnglish, 1))
+	assert.Equal(t, 1, i18n.GetPluralForm(constants.LanguageEnglish, 2))
+	assert.Equal(t, 0, i18n.GetPluralForm(constants.LanguageChinese, 5))
+	assert.Equal(t, 0, i18n.GetPluralForm(constants.LanguageRussian, 21))
+	assert.Equal(t, 1, i18n.GetPluralForm(constants.LanguageRussian, 23))
+	assert.Equal(t, 2, i18n.GetPluralForm(constants.LanguageRussian, 12))
+	assert.Equal(t, 2, i18n.GetPluralForm(constants.LanguageRussian, 111))
+}
+
+func TestFormatDate(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, 3, 5, 10, 20, 0, 0, time.UTC)
+	assert.Equal(t, "05 Mar 24 10:20 UTC", i18n.GetLocalizer(constants.LanguageEnglish).FormatDate(date))
+
+	localizer := &i18n.Localizer{Language: constants.LanguageEnglish, Catalog: &i18n.Catalog{}}
+	assert.Equal(t, date.Format(time.RFC822), localizer.FormatDate(date))
+}
+
+func TestFormatDateMonthNames(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, 3, 5, 10, 20, 0, 0, time.UTC)
+	assert.Equal(t, "5 марта 2024 10:20 UTC", i18n.GetLocalizer(constants.LanguageRussian).FormatDate(date))
+	assert.Equal(t, "5 marzo 2024 10:20 UTC", i18n.GetLocalizer(constants.LanguageSpanish).FormatDate(date))
+	assert.Equal(t, "2024年3月5日 10:20 UTC", i18n.GetLocalizer(constants.LanguageChinese).FormatDate(date))
+
+	localizer := i18n.GetLocalizer(constants.LanguageSpanish)
+	localizer = &i18n.Localizer{
+		Language: localizer.Language,
+		Catalog:  &i18n.Catalog{DateFormat: "Jan 2", ShortMonths: localizer.Catalog.ShortMonths},
+	}
+	assert.Equal(t, "mar 5", localizer.FormatDate(date))
+}
+
+func TestCatalogsHaveAllTemplatesMessages(t *testing.T) {
+	t.Parallel()
+
+	messageRegexp := regexp.MustCompile(`\{\{-?\s*T\s+("(?:[^"\\]|\\.)*"|` + "`[^`]*`" + `)`)
+	messages := map[string]bool{}
+
+	err := fs.WalkDir(templates.TemplatesFs, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		content, err := fs.ReadFile(templates.TemplatesFs, path)
+		if err != nil {
+			return err
+		}
+
+		for _, match := range messageRegexp.FindAllStringSubmatch(string(content), -1) {
+			message, err := strconv.Unquote(match[1])
+			require.NoError(t, err)
+			messages[message] = true
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, messages)
+
+	catalogs := i18n.MustLoadCatalogs(locales.LocalesFs)
+	for _, language := range constants.GetLanguages() {
+		if language == constants.LanguageEnglish {
+			continue
+		}
+
+		for message := range messages {
+			assert.Contains(t, catalogs[language].Messages, message, "%s catalog misses a message", language)
+		}
+
+		assert.Len(t, catalogs[language].Messages, len(catalogs[constants.LanguageRussian].Messages))
+		for message := range catalogs[constants.LanguageRussian].Messages {
+			assert.Contains(t, catalogs[language].Messages, message, "%s catalog misses a message", language)
+		}
+	}
+}
@@ -36,8 +36,9 @@ type Manager struct {
 
 	totalBlocksGauge *prometheus.GaugeVec
 
-	reporterEnabledGauge   *prometheus.GaugeVec
-	reporterQueriesCounter *prometheus.CounterVec
+	reporterEnabledGauge         *prometheus.GaugeVec
+	reporterQueriesCounter       *prometheus.CounterVec
+	reporterDeniedQueriesCounter *prometheus.CounterVec
 
 	missingBlocksGauge         *prometheus.GaugeVec
 	activeBlocksGauge          *prometheus.GaugeVec
@@ -57,6 +58,10 @@ type Manager struct {
 	minSignedPerWindowGauge *prometheus.GaugeVec
 
 	storeBlocksGauge *prometheus.GaugeVec
+
+	outboxQueueDepthGauge       *prometheus.GaugeVec
+	outboxOldestPendingAgeGauge *prometheus.GaugeVec
+	outboxDeliveriesCounter     *prometheus.CounterVec
 }
 
 func NewManager(logger zerolog.Logger, config configPkg.MetricsConfig) *Manager {
@@ -102,6 +107,10 @@ func NewManager(logger zerolog.Logger, config configPkg.MetricsConfig) *Manager
 		Name: constants.PrometheusMetricsPrefix + "reporter_queries",
 		Help: "Reporters' queries count ",
 	}, []string{"chain", "name", "query"})
+	reporterDeniedQueriesCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: constants.PrometheusMetricsPrefix + "reporter_denied_queries",
+		Help: "Reporters' queries count the users were not allowed to run",
+	}, []string{"chain", "name", "query"})
 	appVersionGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: constants.PrometheusMetricsPrefix + "version",
 		Help: "App version",
@@ -166,6 +175,18 @@ func NewManager(logger zerolog.Logger, config configPkg.MetricsConfig) *Manager
 		Name: constants.PrometheusMetricsPrefix + "chain_info",
 		Help: "Chain info, with constant 1 as value and pretty_name and chain as labels",
 	}, []string{"chain", "pretty_name"})
+	outboxQueueDepthGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "outbox_queue_depth",
+		Help: "Count of reports pending delivery in the outbox",
+	}, []string{"chain", "name"})
+	outboxOldestPendingAgeGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: constants.PrometheusMetricsPrefix + "outbox_oldest_pending_age_seconds",
+		Help: "Age of the oldest report pending delivery in the outbox, in seconds",
+	}, []string{"chain", "name"})
+	outboxDeliveriesCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: constants.PrometheusMetricsPrefix + "outbox_deliveries_total",
+		Help: "Count of outbox delivery attempts",
+	}, []string{"chain", "name", "status"})
 
 	registry.MustRegister(lastBlockHeightCollector)
 	registry.MustRegister(lastBlockTimeCollector)
@@ -177,6 +198,7 @@ func NewManager(logger zerolog.Logger, config configPkg.MetricsConfig) *Manager
 	registry.MustRegister(totalBlocksGauge)
 	registry.MustRegister(reporterEnabledGauge)
 	registry.MustRegister(reporterQueriesCounter)
+	registry.MustRegister(reporterDeniedQueriesCounter)
 	registry.MustRegister(appVersionGauge)
 	registry.MustRegister(startTimeGauge)
 	registry.MustRegister(eventsCounter)
@@ -193,6 +215,9 @@ func NewManager(logger zerolog.Logger, config configPkg.MetricsConfig) *Manager
 	registry.MustRegister(storeBlocksGauge)
 	registry.MustRegister(minSignedPerWindowGauge)
 	registry.MustRegister(chainInfoGauge)
+	registry.MustRegister(outboxQueueDepthGauge)
+	registry.MustRegister(outboxOldestPendingAgeGauge)
+	registry.MustRegister(outboxDeliveriesCounter)
 
 	startTimeGauge.
 		With(prometheus.Labels{}).
@@ -201,36 +226,40 @@ func NewManager(logger zerolog.Logger, config configPkg.MetricsConfig) *Manager
 	server := &http.Server{Addr: config.ListenAddr, Handler: nil}
 
 	return &Manager{
-		logger:                     logger.With().Str("component", "metrics").Logger(),
-		config:                     config,
-		registry:                   registry,
-		lastBlockHeightCollector:   lastBlockHeightCollector,
-		lastBlockTimeCollector:     lastBlockTimeCollector,
-		nodeConnectedCollector:     nodeConnectedCollector,
-		successfulQueriesCollector: successfulQueriesCollector,
-		failedQueriesCollector:     failedQueriesCollector,
-		reportsCounter:             reportsCounter,
-		reportEntriesCounter:       reportEntriesCounter,
-		totalBlocksGauge:           totalBlocksGauge,
-		reporterEnabledGauge:       reporterEnabledGauge,
-		reporterQueriesCounter:     reporterQueriesCounter,
-		appVersionGauge:            appVersionGauge,
-		startTimeGauge:             startTimeGauge,
-		eventsCounter:              eventsCounter,
-		reconnectsCounter:          reconnectsCounter,
-		missingBlocksGauge:         missingBlocksGauge,
-		activeBlocksGauge:          activeBlocksGauge,
-		cumulativeVotingPowerGauge: cumulativeVotingPowerGauge,
-		votingPowerGauge:           votingPowerGauge,
-		validatorRankGauge:         validatorRankGauge,
-		isActiveGauge:              isActiveGauge,
-		isJailedGauge:              isJailedGauge,
-		isTombstonedGauge:          isTombstonedGauge,
-		signedBlocksWindowGauge:    signedBlocksWindowGauge,
-		storeBlocksGauge:           storeBlocksGauge,
-		minSignedPerWindowGauge:    minSignedPerWindowGauge,
-		chainInfoGauge:             chainInfoGauge,
-		server:                     server,
+		logger:                       logger.With().Str("component", "metrics").Logger(),
+		config:                       config,
+		registry:                     registry,
+		lastBlockHeightCollector:     lastBlockHeightCollector,
+		lastBlockTimeCollector:       lastBlockTimeCollector,
+		nodeConnectedCollector:       nodeConnectedCollector,
+		successfulQueriesCollector:   successfulQueriesCollector,
+		failedQueriesCollector:       failedQueriesCollector,
+		reportsCounter:               reportsCounter,
+		reportEntriesCounter:         reportEntriesCounter,
+		totalBlocksGauge:             totalBlocksGauge,
+		reporterEnabledGauge:         reporterEnabledGauge,
+		reporterQueriesCounter:       reporterQueriesCounter,
+		reporterDeniedQueriesCounter: reporterDeniedQueriesCounter,
+		appVersionGauge:              appVersionGauge,
+		startTimeGauge:               startTimeGauge,
+		eventsCounter:                eventsCounter,
+		reconnectsCounter:            reconnectsCounter,
+		missingBlocksGauge:           missingBlocksGauge,
+		activeBlocksGauge:            activeBlocksGauge,
+		cumulativeVotingPowerGauge:   cumulativeVotingPowerGauge,
+		votingPowerGauge:             votingPowerGauge,
+		validatorRankGauge:           validatorRankGauge,
+		isActiveGauge:                isActiveGauge,
+		isJailedGauge:                isJailedGauge,
+		isTombstonedGauge:            isTombstonedGauge,
+		signedBlocksWindowGauge:      signedBlocksWindowGauge,
+		storeBlocksGauge:             storeBlocksGauge,
+		minSignedPerWindowGauge:      minSignedPerWindowGauge,
+		chainInfoGauge:               chainInfoGauge,
+		outboxQueueDepthGauge:        outboxQueueDepthGauge,
+		outboxOldestPendingAgeGauge:  outboxOldestPendingAgeGauge,
+		outboxDeliveriesCounter:      outboxDeliveriesCounter,
+		server:                       server,
 	}
 }
 
@@ -489,3 +518,34 @@ func (m *Manager) LogReporterQuery(chain string, reporter constants.ReporterName
 		}).
 		Inc()
 }
+
+func (m *Manager) LogReporterDeniedQuery(chain string, reporter constants.ReporterName, query string) {
+	m.reporterDeniedQueriesCounter.
+		With(prometheus.Labels{
+			"chain": chain,
+			"name":  string(reporter),
+			"query": query,
+		}).
+		Inc()
+}
+
+func (m *Manager) LogOutboxStats(chain string, reporter constants.ReporterName, depth int, oldestAge time.Duration) {
+	m.outboxQueueDepthGauge.
+		With(prometheus.Labels{"chain": chain, "name": string(reporter)}).
+		Set(float64(depth))
+
+	m.outboxOldestPendingAgeGauge.
+		With(prometheus.Labels{"chain": chain, "name": string(reporter)}).
+		Set(oldestAge.Seconds())
+}
+
+func (m *Manager) LogOutboxDelivery(chain string, reporter constants.ReporterName, success bool) {
+	status := "success"
+	if !success {
+		status = "failure"
+	}
+
+	m.outboxDeliveriesCounter.
+		With(prometheus.Labels{"chain": chain, "name": string(reporter), "status": status}).
+		Inc()
+}
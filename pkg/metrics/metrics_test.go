@@ -412,6 +412,23 @@ func TestMetricsManagerLogReporterQuery(t *testing.T) {
 	})), 0.01)
 }
 
+func TestMetricsManagerLogReporterDeniedQuery(t *testing.T) {
+	t.Parallel()
+
+	config := configPkg.MetricsConfig{Enabled: null.BoolFrom(true), ListenAddr: "invalid"}
+	logger := loggerPkg.GetNopLogger()
+	manager := NewManager(*logger, config)
+
+	manager.LogReporterDeniedQuery("chain", constants.DiscordReporterName, "subscribe")
+
+	assert.Equal(t, 1, testutil.CollectAndCount(manager.reporterDeniedQueriesCounter))
+	assert.InDelta(t, 1, testutil.ToFloat64(manager.reporterDeniedQueriesCounter.With(prometheus.Labels{
+		"chain": "chain",
+		"query": "subscribe",
+		"name":  string(constants.DiscordReporterName),
+	})), 0.01)
+}
+
 func TestMetricsManagerSetDefaultMetrics(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,289 @@
+package outbox
+
+import (
+	"errors"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	databasePkg "main/pkg/database"
+	"main/pkg/digest"
+	"main/pkg/metrics"
+	reportersPkg "main/pkg/reporters"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type Manager struct {
+	logger         zerolog.Logger
+	config         *configPkg.ChainConfig
+	database       *databasePkg.Database
+	metricsManager *metrics.Manager
+	digestManager  *digest.Manager
+	reporters      []reportersPkg.Reporter
+	mutex          sync.Mutex
+	digestMutex    sync.Mutex
+}
+
+type stats struct {
+	depth  int
+	oldest time.Time
+}
+
+func NewManager(
+	logger zerolog.Logger,
+	chainConfig *configPkg.ChainConfig,
+	database *databasePkg.Database,
+	metricsManager *metrics.Manager,
+	digestManager *digest.Manager,
+	reporters []reportersPkg.Reporter,
+) *Manager {
+	return &Manager{
+		logger:         logger.With().Str("component", "outbox_manager").Logger(),
+		config:         chainConfig,
+		database:       database,
+		metricsManager: metricsManager,
+		digestManager:  digestManager,
+		reporters:      reporters,
+	}
+}
+
+// Init rebuilds the reporters' digests from the events stored in the outbox before the restart.
+func (m *Manager) Init() {
+	entries, err := m.database.GetDigestOutboxEntries(m.config.Name)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("Could not restore digests")
+		return
+	}
+
+	for _, entry := range entries {
+		m.digestManager.Restore(entry.Reporter, entry.CreatedAt, entry.Report)
+	}
+
+	m.logger.Debug().Int("entries", len(entries)).Msg("Restored digests")
+}
+
+func (m *Manager) Enqueue(report *types.Report) {
+	for _, reporter := range m.reporters {
+		if reporter.Enabled() {
+			m.enqueueForReporter(reporter, m.addToDigest(reporter, report))
+		}
+	}
+}
+
+// addToDigest puts the report events into the reporter's digest, storing them in the outbox
+// so the digest is not lost on restart, and returns the events that should be sent right away.
+func (m *Manager) addToDigest(reporter reportersPkg.Reporter, report *types.Report) *types.Report {
+	m.digestMutex.Lock()
+	defer m.digestMutex.Unlock()
+
+	immediate, buffered := m.digestManager.Add(reporter.Name(), report)
+	if buffered.Empty() {
+		return immediate
+	}
+
+	if err := m.database.InsertOutboxEntry(m.config.Name, reporter.Name(), buffered, constants.OutboxStatusDigest); err != nil {
+		m.logger.Error().
+			Err(err).
+			Str("name", string(reporter.Name())).
+			Msg("Could not store digest events, they would be lost on restart")
+	}
+
+	return immediate
+}
+
+// flushDigests puts the digests whose window has passed into the outbox,
+// removing the events they were made of from it.
+func (m *Manager) flushDigests() error {
+	m.digestMutex.Lock()
+	defer m.digestMutex.Unlock()
+
+	var errs []error
+
+	for reporterName, report := range m.digestManager.PopDue(time.Now()) {
+		if reporter, found := m.getReporter(reporterName); found && reporter.Enabled() {
+			m.enqueueForReporter(reporter, report)
+		}
+
+		if err := m.database.DeleteDigestOutboxEntries(m.config.Name, reporterName); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *Manager) enqueueForReporter(reporter reportersPkg.Reporter, report *types.Report) {
+	if report.Empty() {
+		return
+	}
+
+	if err := m.database.InsertOutboxEntry(m.config.Name, reporter.Name(), report, constants.OutboxStatusPending); err != nil {
+		m.logger.Error().
+			Err(err).
+			Str("name", string(reporter.Name())).
+			Msg("Could not enqueue report, sending it directly")
+
+		// the report may be shared with other reporters, so their deliveries are not mixed up
+		sendErr := reporter.Send(&types.Report{Height: report.Height, Events: report.Events})
+		if sendErr != nil {
+			m.logger.Error().
+				Err(sendErr).
+				Str("name", string(reporter.Name())).
+				Msg("Error sending report")
+		}
+
+		// it is not retried, so a failure here means the report is dropped
+		m.metricsManager.LogOutboxDelivery(m.config.Name, reporter.Name(), sendErr == nil)
+	}
+}
+
+func (m *Manager) Flush() error {
+	digestsErr := m.flushDigests()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	entries, err := m.database.GetPendingOutboxEntries(m.config.Name)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	reportersStats := make(map[constants.ReporterName]*stats, len(m.reporters))
+	errs := []error{digestsErr}
+
+	for _, reporter := range m.reporters {
+		if reporter.Enabled() {
+			reportersStats[reporter.Name()] = &stats{}
+		}
+	}
+
+	for _, entry := range entries {
+		reporterStats, enabled := reportersStats[entry.Reporter]
+		if !enabled {
+			m.logger.Warn().
+				Int64("id", entry.ID).
+				Str("name", string(entry.Reporter)).
+				Msg("Reporter is disabled, dropping outbox entry")
+			errs = append(errs, m.database.UpdateOutboxEntry(
+				entry.ID,
+				constants.OutboxStatusFailed,
+				entry.Attempts,
+				"reporter is disabled",
+				now,
+				entry.Report.Deliveries,
+			))
+			continue
+		}
+
+		// Entries are delivered in order, so an entry waiting for a retry
+		// holds back the following entries for the same reporter.
+		if reporterStats.depth > 0 || !entry.IsDue(now) {
+			reporterStats.add(entry)
+			continue
+		}
+
+		pending, err := m.deliver(entry, now)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if pending {
+			reporterStats.add(entry)
+		}
+	}
+
+	for reporterName, reporterStats := range reportersStats {
+		var oldestAge time.Duration
+		if reporterStats.depth > 0 {
+			oldestAge = now.Sub(reporterStats.oldest)
+		}
+
+		m.metricsManager.LogOutboxStats(m.config.Name, reporterName, reporterStats.depth, oldestAge)
+	}
+
+	if err := m.database.TrimOutboxBefore(m.config.Name, now.Add(-m.config.OutboxConfig.KeepDelivered*time.Second)); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (m *Manager) deliver(entry *types.OutboxEntry, now time.Time) (bool, error) {
+	reporter, found := m.getReporter(entry.Reporter)
+	if !found {
+		return false, nil
+	}
+
+	attempts := entry.Attempts + 1
+	sendErr := reporter.Send(entry.Report)
+	m.metricsManager.LogOutboxDelivery(m.config.Name, entry.Reporter, sendErr == nil)
+
+	if sendErr == nil {
+		m.logger.Debug().
+			Int64("id", entry.ID).
+			Str("name", string(entry.Reporter)).
+			Int("attempts", attempts).
+			Msg("Delivered outbox entry")
+		return false, m.database.UpdateOutboxEntry(
+			entry.ID,
+			constants.OutboxStatusSent,
+			attempts,
+			"",
+			now,
+			entry.Report.Deliveries,
+		)
+	}
+
+	if maxAttempts := m.config.OutboxConfig.MaxAttempts; maxAttempts > 0 && attempts >= maxAttempts {
+		m.logger.Error().
+			Err(sendErr).
+			Int64("id", entry.ID).
+			Str("name", string(entry.Reporter)).
+			Int("attempts", attempts).
+			Msg("Could not deliver outbox entry, giving up")
+		return false, m.database.UpdateOutboxEntry(
+			entry.ID,
+			constants.OutboxStatusFailed,
+			attempts,
+			sendErr.Error(),
+			now,
+			entry.Report.Deliveries,
+		)
+	}
+
+	nextAttemptAt := now.Add(m.config.OutboxConfig.GetRetryDelay(attempts))
+	m.logger.Warn().
+		Err(sendErr).
+		Int64("id", entry.ID).
+		Str("name", string(entry.Reporter)).
+		Int("attempts", attempts).
+		Time("next_attempt_at", nextAttemptAt).
+		Msg("Could not deliver outbox entry, will retry")
+
+	return true, m.database.UpdateOutboxEntry(
+		entry.ID,
+		constants.OutboxStatusPending,
+		attempts,
+		sendErr.Error(),
+		nextAttemptAt,
+		entry.Report.Deliveries,
+	)
+}
+
+func (m *Manager) getReporter(name constants.ReporterName) (reportersPkg.Reporter, bool) {
+	return utils.Find(m.reporters, func(reporter reportersPkg.Reporter) bool {
+		return reporter.Name() == name
+	})
+}
+
+func (s *stats) add(entry *types.OutboxEntry) {
+	if s.depth == 0 || entry.CreatedAt.Before(s.oldest) {
+		s.oldest = entry.CreatedAt
+	}
+
+	s.depth++
+}
@@ -0,0 +1,30 @@
+package populators
+
+import (
+	"main/pkg/constants"
+	"main/pkg/outbox"
+)
+
+type OutboxPopulator struct {
+	OutboxManager *outbox.Manager
+}
+
+func NewOutboxPopulator(
+	outboxManager *outbox.Manager,
+) *OutboxPopulator {
+	return &OutboxPopulator{
+		OutboxManager: outboxManager,
+	}
+}
+
+func (p *OutboxPopulator) Populate() error {
+	return p.OutboxManager.Flush()
+}
+
+func (p *OutboxPopulator) Enabled() bool {
+	return true
+}
+
+func (p *OutboxPopulator) Name() constants.PopulatorType {
+	return constants.PopulatorOutbox
+}
@@ -0,0 +1,372 @@
+package alertmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	httpPkg "main/pkg/http"
+	"main/pkg/metrics"
+	snapshotPkg "main/pkg/snapshot"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	AlertName = "MissedBlocksChecker"
+)
+
+type Reporter struct {
+	URLs   []string
+	Labels map[string]string
+
+	// firing alerts by validator and event name, pushed again on each snapshot
+	ActiveAlerts map[string]Alert
+	mutex        sync.Mutex
+
+	Clients        []*httpPkg.Client
+	Logger         zerolog.Logger
+	Config         *config.ChainConfig
+	Manager        *statePkg.Manager
+	MetricsManager *metrics.Manager
+}
+
+func NewReporter(
+	chainConfig *config.ChainConfig,
+	logger zerolog.Logger,
+	manager *statePkg.Manager,
+	metricsManager *metrics.Manager,
+) *Reporter {
+	return &Reporter{
+		URLs:           chainConfig.AlertmanagerConfig.URLs,
+		Labels:         chainConfig.AlertmanagerConfig.Labels,
+		ActiveAlerts:   make(map[string]Alert),
+		Config:         chainConfig,
+		Logger:         logger.With().Str("component", "alertmanager_reporter").Logger(),
+		Manager:        manager,
+		MetricsManager: metricsManager,
+	}
+}
+
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("Alertmanager URLs not set, not creating Alertmanager reporter")
+		return
+	}
+
+	reporter.Clients = utils.Map(reporter.URLs, func(url string) *httpPkg.Client {
+		return httpPkg.NewClient(reporter.Logger, reporter.MetricsManager, url, reporter.Config.Name)
+	})
+}
+
+func (reporter *Reporter) Enabled() bool {
+	return len(reporter.URLs) > 0
+}
+
+func (reporter *Reporter) Name() constants.ReporterName {
+	return constants.AlertmanagerReporterName
+}
+
+func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
+	validator := event.GetValidator()
+
+	eventToRender := types.RenderEventItem{
+		Event:         event,
+		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+	}
+
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok && eventChanged.IsIncreasing() {
+		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
+	}
+
+	return eventToRender
+}
+
+func (reporter *Reporter) GetLabels(
+	validator *types.Validator,
+	eventName constants.EventName,
+) map[string]string {
+	labels := make(map[string]string, len(reporter.Labels)+5)
+	for key, value := range reporter.Labels {
+		labels[key] = value
+	}
+
+	labels["alertname"] = AlertName
+	labels["chain"] = reporter.Config.Name
+	labels["validator"] = validator.OperatorAddress
+	labels["moniker"] = validator.Moniker
+	labels["event_type"] = string(eventName)
+
+	return labels
+}
+
+func (reporter *Reporter) GetSummary(event types.RenderEventItem) string {
+	validator := event.Event.GetValidator()
+
+	switch entry := event.Event.(type) {
+	case events.ValidatorGroupChanged:
+		summary := fmt.Sprintf("%s %s", validator.Moniker, entry.GetDescription())
+		if entry.IsIncreasing() {
+			summary += fmt.Sprintf(" (%s till jail)", utils.FormatDuration(event.TimeToJail))
+		}
+
+		return summary
+	case events.ValidatorJailed:
+		return validator.Moniker + " has been jailed"
+	case events.ValidatorUnjailed:
+		return validator.Moniker + " has been unjailed"
+	case events.ValidatorTombstoned:
+		return validator.Moniker + " has been tombstoned"
+	case events.ValidatorActive:
+		return validator.Moniker + " is now in the active set"
+	case events.ValidatorInactive:
+		return validator.Moniker + " is now not in the active set"
+	default:
+		return fmt.Sprintf("%s: %s", event.Event.Type(), validator.Moniker)
+	}
+}
+
+func (reporter *Reporter) SerializeAlert(height int64, event types.RenderEventItem, now time.Time) Alert {
+	alert := Alert{
+		Labels: reporter.GetLabels(event.Event.GetValidator(), event.Event.Type()),
+		Annotations: map[string]string{
+			"summary": reporter.GetSummary(event),
+			"height":  strconv.FormatInt(height, 10),
+		},
+		StartsAt:     now,
+		GeneratorURL: event.ValidatorLink.Href,
+	}
+
+	if entry, ok := event.Event.(events.ValidatorGroupChanged); ok {
+		alert.Annotations["missed_blocks"] = strconv.FormatInt(entry.MissedBlocksAfter, 10)
+	}
+
+	return alert
+}
+
+// SerializeAlerts returns the alerts to push for the event. Firing alerts have no endsAt
+// and are kept active, so they are pushed again on each snapshot, and resolving alerts
+// are the firing ones with endsAt set to now.
+func (reporter *Reporter) SerializeAlerts(height int64, event types.RenderEventItem, now time.Time) []Alert {
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	validator := event.Event.GetValidator()
+	alert := reporter.SerializeAlert(height, event, now)
+
+	switch entry := event.Event.(type) {
+	case events.ValidatorJailed, events.ValidatorTombstoned:
+		reporter.fireAlert(validator, event.Event.Type(), alert)
+	case events.ValidatorGroupChanged:
+		if entry.MissedBlocksGroupAfter.Start == 0 {
+			return []Alert{reporter.resolveAlert(validator, constants.EventValidatorGroupChanged, alert, now)}
+		}
+
+		reporter.fireAlert(validator, constants.EventValidatorGroupChanged, alert)
+	case events.ValidatorUnjailed:
+		// Validator recovered, so the jailed and missing blocks alerts should be resolved.
+		jailedAlert := reporter.SerializeAlert(height, reporter.SerializeEvent(events.ValidatorJailed{Validator: validator}), now)
+		alerts := []Alert{
+			alert,
+			reporter.resolveAlert(validator, constants.EventValidatorJailed, jailedAlert, now),
+		}
+
+		if groupAlert, found := reporter.ActiveAlerts[alertKey(validator, constants.EventValidatorGroupChanged)]; found {
+			alerts = append(alerts, reporter.resolveAlert(validator, constants.EventValidatorGroupChanged, groupAlert, now))
+		}
+
+		return alerts
+	}
+
+	return []Alert{alert}
+}
+
+// RefreshAlerts returns the active alerts that are still firing according to the snapshot,
+// so Alertmanager does not resolve them after its resolve_timeout, and resolves the rest.
+func (reporter *Reporter) RefreshAlerts(height int64, snapshot snapshotPkg.Snapshot, now time.Time) []Alert {
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	alerts := make([]Alert, 0, len(reporter.ActiveAlerts))
+	firing := make(map[string]bool, len(reporter.ActiveAlerts))
+
+	for _, entry := range snapshot.Entries {
+		event, ok := reporter.GetFiringEvent(entry)
+		if !ok {
+			continue
+		}
+
+		key := alertKey(entry.Validator, event.Type())
+		alert, found := reporter.ActiveAlerts[key]
+
+		// active alerts are lost on restart, so they are restored for the validators with open incidents
+		if !found {
+			if _, incidentFound := reporter.Manager.GetIncident(entry.Validator.OperatorAddress); !incidentFound {
+				continue
+			}
+
+			alert = reporter.SerializeAlert(height, reporter.SerializeEvent(event), now)
+			reporter.ActiveAlerts[key] = alert
+		}
+
+		firing[key] = true
+		alerts = append(alerts, alert)
+	}
+
+	for key, alert := range reporter.ActiveAlerts {
+		if !firing[key] {
+			alert.EndsAt = &now
+			alerts = append(alerts, alert)
+			delete(reporter.ActiveAlerts, key)
+		}
+	}
+
+	return alerts
+}
+
+// GetFiringEvent returns the event the validator should have a firing alert for, if any.
+func (reporter *Reporter) GetFiringEvent(entry *types.Entry) (types.ReportEvent, bool) {
+	validator := entry.Validator
+
+	if validator.SigningInfo != nil && validator.SigningInfo.Tombstoned {
+		return events.ValidatorTombstoned{Validator: validator}, true
+	}
+
+	if validator.Jailed {
+		return events.ValidatorJailed{Validator: validator}, true
+	}
+
+	if !entry.IsActive {
+		return nil, false
+	}
+
+	missedBlocks := entry.SignatureInfo.GetNotSigned()
+	group, _, err := reporter.Config.MissedBlocksGroups.GetGroup(missedBlocks)
+	if err != nil || group.Start == 0 {
+		return nil, false
+	}
+
+	return events.ValidatorGroupChanged{
+		Validator:               validator,
+		MissedBlocksAfter:       missedBlocks,
+		MissedBlocksGroupBefore: &config.MissedBlocksGroup{},
+		MissedBlocksGroupAfter:  group,
+	}, true
+}
+
+func (reporter *Reporter) fireAlert(validator *types.Validator, eventName constants.EventName, alert Alert) {
+	reporter.ActiveAlerts[alertKey(validator, eventName)] = alert
+}
+
+// resolveAlert returns the active alert with endsAt set to now, or the given one
+// if there is no active alert, as it might have been lost on restart.
+func (reporter *Reporter) resolveAlert(
+	validator *types.Validator,
+	eventName constants.EventName,
+	alert Alert,
+	now time.Time,
+) Alert {
+	key := alertKey(validator, eventName)
+	if activeAlert, found := reporter.ActiveAlerts[key]; found {
+		alert = activeAlert
+		delete(reporter.ActiveAlerts, key)
+	}
+
+	alert.EndsAt = &now
+	return alert
+}
+
+func alertKey(validator *types.Validator, eventName constants.EventName) string {
+	return validator.OperatorAddress + "/" + string(eventName)
+}
+
+func (reporter *Reporter) ReportSnapshot(height int64, snapshot snapshotPkg.Snapshot) error {
+	if !reporter.Enabled() {
+		return nil
+	}
+
+	alerts := reporter.RefreshAlerts(height, snapshot, time.Now())
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		reporter.Logger.Error().Err(err).Msg("Could not marshal Alertmanager alerts")
+		return err
+	}
+
+	var errs []error
+
+	for _, client := range reporter.Clients {
+		if err := reporter.Post(client, string(body)); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("url", client.Host).
+				Msg("Could not refresh alerts in Alertmanager")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (reporter *Reporter) Post(client *httpPkg.Client, content string) error {
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+
+	return client.Post("/api/v2/alerts", constants.QueryTypeAlertmanager, []byte(content), headers)
+}
+
+func (reporter *Reporter) Send(report *types.Report) error {
+	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
+
+	// the alerts are rendered once and stored for each Alertmanager, so a retry posts the same body
+	var body []byte
+	render := func() (string, error) {
+		if body != nil {
+			return string(body), nil
+		}
+
+		now := time.Now()
+		alerts := make([]Alert, 0, len(report.Events))
+
+		for _, event := range report.Events {
+			alerts = append(alerts, reporter.SerializeAlerts(report.Height, reporter.SerializeEvent(event), now)...)
+		}
+
+		rendered, err := json.Marshal(alerts)
+		if err != nil {
+			reporter.Logger.Error().Err(err).Msg("Could not marshal Alertmanager alerts")
+			return "", err
+		}
+
+		body = rendered
+		return string(body), nil
+	}
+
+	var errs []error
+
+	for _, client := range reporter.Clients {
+		if err := report.Deliver("url:"+client.Host, render, func(content string) error {
+			return reporter.Post(client, content)
+		}); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("url", client.Host).
+				Msg("Could not send alerts to Alertmanager")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
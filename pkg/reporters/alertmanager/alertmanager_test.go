@@ -0,0 +1,130 @@
+package alertmanager
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	snapshotPkg "main/pkg/snapshot"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func newTestReporter(t *testing.T) *Reporter {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.ChainConfig{
+		Name:         "chain",
+		BlocksWindow: 10000,
+		MissedBlocksGroups: configPkg.MissedBlocksGroups{
+			{Start: 0, End: 99, DescEnd: "is recovered"},
+			{Start: 100, End: 9999, DescStart: "is skipping blocks"},
+			{Start: 10000, End: 10000, DescStart: "is skipping all blocks"},
+		},
+		AlertmanagerConfig: configPkg.AlertmanagerConfig{URLs: []string{"http://localhost:9093"}},
+	}
+	metricsManager := metrics.NewManager(*logger, configPkg.MetricsConfig{Enabled: null.BoolFrom(false)})
+	snapshotManager := snapshotPkg.NewManager(*logger, config, metricsManager)
+	stateManager := statePkg.NewManager(*logger, config, metricsManager, snapshotManager, nil)
+
+	return NewReporter(config, *logger, stateManager, metricsManager)
+}
+
+func TestSerializeAlertsJailedAndUnjailed(t *testing.T) {
+	t.Parallel()
+
+	reporter := newTestReporter(t)
+	validator := &types.Validator{Moniker: "test", OperatorAddress: "cosmosvaloper1xxx"}
+	firedAt := time.Unix(1700000000, 0)
+	resolvedAt := firedAt.Add(time.Hour)
+
+	firing := reporter.SerializeAlerts(100, reporter.SerializeEvent(events.ValidatorJailed{Validator: validator}), firedAt)
+	require.Len(t, firing, 1)
+	assert.Nil(t, firing[0].EndsAt)
+	assert.Equal(t, "test has been jailed", firing[0].Annotations["summary"])
+
+	alerts := reporter.SerializeAlerts(200, reporter.SerializeEvent(events.ValidatorUnjailed{Validator: validator}), resolvedAt)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, string(constants.EventValidatorUnjailed), alerts[0].Labels["event_type"])
+	assert.Nil(t, alerts[0].EndsAt)
+
+	resolved := alerts[1]
+	require.NotNil(t, resolved.EndsAt)
+	assert.Equal(t, resolvedAt, *resolved.EndsAt)
+	assert.Equal(t, firing[0].Labels, resolved.Labels)
+	assert.Equal(t, firing[0].Annotations, resolved.Annotations)
+	assert.Equal(t, firedAt, resolved.StartsAt)
+	assert.Empty(t, reporter.ActiveAlerts)
+}
+
+func TestSerializeAlertsGroupChanged(t *testing.T) {
+	t.Parallel()
+
+	reporter := newTestReporter(t)
+	validator := &types.Validator{Moniker: "test", OperatorAddress: "cosmosvaloper1xxx"}
+	groups := reporter.Config.MissedBlocksGroups
+	now := time.Unix(1700000000, 0)
+
+	firing := reporter.SerializeAlerts(100, types.RenderEventItem{Event: events.ValidatorGroupChanged{
+		Validator:               validator,
+		MissedBlocksBefore:      50,
+		MissedBlocksAfter:       150,
+		MissedBlocksGroupBefore: groups[0],
+		MissedBlocksGroupAfter:  groups[1],
+	}}, now)
+	require.Len(t, firing, 1)
+	assert.Nil(t, firing[0].EndsAt)
+	assert.Equal(t, "150", firing[0].Annotations["missed_blocks"])
+
+	resolved := reporter.SerializeAlerts(200, reporter.SerializeEvent(events.ValidatorGroupChanged{
+		Validator:               validator,
+		MissedBlocksBefore:      150,
+		MissedBlocksAfter:       50,
+		MissedBlocksGroupBefore: groups[1],
+		MissedBlocksGroupAfter:  groups[0],
+	}), now.Add(time.Hour))
+	require.Len(t, resolved, 1)
+	require.NotNil(t, resolved[0].EndsAt)
+	assert.Equal(t, firing[0].Labels, resolved[0].Labels)
+	assert.Equal(t, firing[0].Annotations, resolved[0].Annotations)
+	assert.Empty(t, reporter.ActiveAlerts)
+}
+
+func TestRefreshAlerts(t *testing.T) {
+	t.Parallel()
+
+	reporter := newTestReporter(t)
+	validator := &types.Validator{Moniker: "test", OperatorAddress: "cosmosvaloper1xxx", Jailed: true}
+	otherValidator := &types.Validator{Moniker: "other", OperatorAddress: "cosmosvaloper1yyy", Jailed: true}
+	now := time.Unix(1700000000, 0)
+
+	firing := reporter.SerializeAlerts(100, reporter.SerializeEvent(events.ValidatorJailed{Validator: validator}), now)
+	require.Len(t, firing, 1)
+
+	// the other validator has no open incident, so there is no alert to restore for it
+	alerts := reporter.RefreshAlerts(110, snapshotPkg.Snapshot{Entries: types.Entries{
+		validator.OperatorAddress:      {Validator: validator},
+		otherValidator.OperatorAddress: {Validator: otherValidator},
+	}}, now.Add(time.Minute))
+	require.Equal(t, firing, alerts)
+
+	unjailedValidator := &types.Validator{Moniker: "test", OperatorAddress: "cosmosvaloper1xxx"}
+	resolvedAt := now.Add(time.Hour)
+	alerts = reporter.RefreshAlerts(120, snapshotPkg.Snapshot{Entries: types.Entries{
+		validator.OperatorAddress: {Validator: unjailedValidator, IsActive: true},
+	}}, resolvedAt)
+	require.Len(t, alerts, 1)
+	require.NotNil(t, alerts[0].EndsAt)
+	assert.Equal(t, resolvedAt, *alerts[0].EndsAt)
+	assert.Equal(t, firing[0].Annotations, alerts[0].Annotations)
+
+	assert.Empty(t, reporter.RefreshAlerts(130, snapshotPkg.Snapshot{}, resolvedAt))
+}
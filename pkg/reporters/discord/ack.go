@@ -0,0 +1,156 @@
+package discord
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	AckButtonPrefix = "ack"
+
+	// Discord allows up to 5 buttons in an action row, and up to 5 rows in a message.
+	MaxButtonsInRow      = 5
+	MaxButtonsInMessage  = 25
+	MaxButtonLabelLength = 80
+)
+
+func GetUser(i *discordgo.InteractionCreate) *discordgo.User {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User
+	}
+
+	return i.User
+}
+
+func GetUserID(i *discordgo.InteractionCreate) string {
+	if user := GetUser(i); user != nil {
+		return user.ID
+	}
+
+	return ""
+}
+
+func GetRoles(i *discordgo.InteractionCreate) []string {
+	if i.Member != nil {
+		return i.Member.Roles
+	}
+
+	return nil
+}
+
+func SerializeUser(i *discordgo.InteractionCreate) string {
+	user := GetUser(i)
+	if user == nil {
+		return "unknown"
+	}
+
+	return "@" + user.Username
+}
+
+// GetAckComponents returns an "Acknowledge" button for each validator
+// in the report having an incident nobody took yet.
+func (reporter *Reporter) GetAckComponents(reportEvents []types.ReportEvent) []discordgo.MessageComponent {
+	buttons := make([]discordgo.MessageComponent, 0)
+	added := make(map[int64]bool)
+
+	for _, event := range reportEvents {
+		validator := event.GetValidator()
+
+		incident, found := reporter.Manager.GetIncident(validator.OperatorAddress)
+		if !found || incident.IsAcked() || added[incident.ID] {
+			continue
+		}
+
+		if len(buttons) >= MaxButtonsInMessage {
+			break
+		}
+
+		label := []rune(reporter.TemplatesManager.Translate("Acknowledge %s", validator.Moniker))
+		if len(label) > MaxButtonLabelLength {
+			label = label[:MaxButtonLabelLength]
+		}
+
+		added[incident.ID] = true
+		buttons = append(buttons, discordgo.Button{
+			Label:    string(label),
+			Style:    discordgo.DangerButton,
+			CustomID: strings.Join([]string{AckButtonPrefix, reporter.Config.Name, strconv.FormatInt(incident.ID, 10)}, "|"),
+		})
+	}
+
+	components := make([]discordgo.MessageComponent, 0)
+	for _, chunk := range utils.SplitIntoChunks(buttons, MaxButtonsInRow) {
+		components = append(components, discordgo.ActionsRow{Components: chunk})
+	}
+
+	return components
+}
+
+// HandleComponent routes the button press to the chain reporter,
+// as the button ID is formatted as "ack|<chain>|<incident ID>".
+func (b *Bot) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	args := strings.Split(i.MessageComponentData().CustomID, "|")
+	if len(args) != 3 || args[0] != AckButtonPrefix {
+		return
+	}
+
+	reporter := b.FindReporter(args[1])
+	if reporter == nil {
+		b.BotRespond(s, i, b.Reporters[0].TemplatesManager.Translate("Unknown chain: %s", args[1]))
+		return
+	}
+
+	if !b.CheckPermissions(s, i, AckButtonPrefix, reporter) {
+		return
+	}
+
+	reporter.HandleAck(s, i, args[2])
+}
+
+func (reporter *Reporter) HandleAck(s *discordgo.Session, i *discordgo.InteractionCreate, incidentIDRaw string) {
+	ackedBy := SerializeUser(i)
+
+	reporter.Logger.Info().
+		Str("sender", ackedBy).
+		Str("incident", incidentIDRaw).
+		Msg("Got incident acknowledgement")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "ack")
+
+	incidentID, err := strconv.ParseInt(incidentIDRaw, 10, 64)
+	if err != nil {
+		reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Invalid incident ID"))
+		return
+	}
+
+	incident, err := reporter.Manager.AckIncident(incidentID, ackedBy)
+	if err != nil {
+		reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Could not acknowledge: %s", err))
+		return
+	}
+
+	if incident.AckedBy != ackedBy {
+		reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Already acknowledged by %s", SerializeCode(incident.AckedBy)))
+		return
+	}
+
+	validatorLink := SerializeCode(incident.OperatorAddress)
+	if validator, found := reporter.Manager.GetValidator(incident.OperatorAddress); found {
+		validatorLink = string(reporter.TemplatesManager.SerializeLink(
+			reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+		))
+	}
+
+	// the response is posted in the channel, so it is in the chain language
+	reporter.BotRespond(s, i, reporter.TemplatesManager.Translate(
+		"%s is handling the incident of %s on %s",
+		SerializeCode(ackedBy),
+		validatorLink,
+		reporter.Config.GetName(),
+	))
+}
@@ -0,0 +1,362 @@
+package discord
+
+import (
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/rs/zerolog"
+)
+
+type Bot struct {
+	Token   string
+	Guild   string
+	Version string
+
+	Permissions config.DiscordPermissions
+
+	DiscordSession *discordgo.Session
+	Logger         zerolog.Logger
+	Reporters      []*Reporter
+	Commands       map[string]*Command
+
+	once sync.Once
+}
+
+func NewBot(
+	token string,
+	guild string,
+	permissions config.DiscordPermissions,
+	version string,
+	logger zerolog.Logger,
+) *Bot {
+	return &Bot{
+		Token:       token,
+		Guild:       guild,
+		Permissions: permissions,
+		Version:     version,
+		Logger:      logger.With().Str("component", "discord_bot").Logger(),
+		Commands:    make(map[string]*Command, 0),
+	}
+}
+
+func (b *Bot) AddReporter(reporter *Reporter) {
+	b.Reporters = append(b.Reporters, reporter)
+}
+
+func (b *Bot) IsShared() bool {
+	return len(b.Reporters) > 1
+}
+
+func (b *Bot) GetChainNames() []string {
+	return utils.Map(b.Reporters, func(reporter *Reporter) string {
+		return reporter.Config.Name
+	})
+}
+
+func (b *Bot) FindReporter(chain string) *Reporter {
+	reporter, _ := utils.Find(b.Reporters, func(reporter *Reporter) bool {
+		return strings.EqualFold(reporter.Config.Name, chain)
+	})
+
+	return reporter
+}
+
+func (b *Bot) GetReporter(i *discordgo.InteractionCreate) *Reporter {
+	if chain := GetOptionValue(i, "chain"); chain != "" {
+		return b.FindReporter(chain)
+	}
+
+	if !b.IsShared() {
+		return b.Reporters[0]
+	}
+
+	return nil
+}
+
+func (b *Bot) Start() {
+	b.once.Do(b.start)
+}
+
+func (b *Bot) start() {
+	session, err := discordgo.New("Bot " + b.Token)
+	if err != nil {
+		b.Logger.Warn().Err(err).Msg("Error initializing Discord bot")
+		return
+	}
+
+	// Open a websocket connection to Discord and begin listening.
+	err = session.Open()
+	if err != nil {
+		b.Logger.Warn().Err(err).Msg("Error opening Discord websocket session")
+		return
+	}
+
+	b.DiscordSession = session
+
+	b.Logger.Info().Strs("chains", b.GetChainNames()).Msg("Discord bot listening")
+
+	for name, command := range b.Reporters[0].Commands {
+		b.Commands[name] = b.WrapCommand(command, nil)
+	}
+
+	b.Commands["status"] = b.WrapCommand(b.Reporters[0].Commands["status"], b.HandleStatus)
+	b.Commands["help"] = b.GetHelpCommand()
+
+	go b.InitCommands()
+}
+
+func (b *Bot) GetChainOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "chain",
+		Description: "Chain name",
+		Required:    false,
+		Choices: utils.Map(b.Reporters, func(reporter *Reporter) *discordgo.ApplicationCommandOptionChoice {
+			return &discordgo.ApplicationCommandOptionChoice{
+				Name:  reporter.Config.GetName(),
+				Value: reporter.Config.Name,
+			}
+		}),
+	}
+}
+
+// WrapCommand routes the command to the chain reporter, falling back to
+// the provided handler if the chain is not specified on a shared bot.
+func (b *Bot) WrapCommand(
+	command *Command,
+	fallback func(s *discordgo.Session, i *discordgo.InteractionCreate),
+) *Command {
+	info := *command.Info
+	if b.IsShared() {
+		info.Options = append(append([]*discordgo.ApplicationCommandOption{}, command.Info.Options...), b.GetChainOption())
+	}
+
+	return &Command{
+		Info: &info,
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter := b.GetReporter(i)
+
+			if !b.CheckPermissions(s, i, info.Name, reporter) {
+				return
+			}
+
+			if reporter != nil {
+				reporter.Commands[info.Name].Handler(s, i)
+				return
+			}
+
+			if fallback != nil {
+				fallback(s, i)
+				return
+			}
+
+			b.BotRespond(s, i, fmt.Sprintf(
+				"Please specify the chain, available chains: %s",
+				strings.Join(b.GetChainNames(), ", "),
+			))
+		},
+	}
+}
+
+// CheckPermissions returns whether the user can run the command, responding to them,
+// logging the attempt and counting it in metrics if they cannot.
+func (b *Bot) CheckPermissions(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	command string,
+	reporter *Reporter,
+) bool {
+	if b.Permissions.IsAllowed(command, GetUserID(i), GetRoles(i)) {
+		return true
+	}
+
+	b.DenyPermissions(s, i, command, reporter)
+	return false
+}
+
+// DenyPermissions responds to the user that they cannot run the command,
+// logging the attempt and counting it in metrics.
+func (b *Bot) DenyPermissions(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	command string,
+	reporter *Reporter,
+) {
+	userID := GetUserID(i)
+
+	b.Logger.Warn().
+		Str("user", SerializeUser(i)).
+		Str("user_id", userID).
+		Str("command", command).
+		Msg("User is not allowed to run the command")
+
+	reporters := b.Reporters
+	if reporter != nil {
+		reporters = []*Reporter{reporter}
+	}
+
+	for _, chainReporter := range reporters {
+		chainReporter.MetricsManager.LogReporterDeniedQuery(
+			chainReporter.Config.Name,
+			constants.DiscordReporterName,
+			command,
+		)
+	}
+
+	message := "You are not allowed to run this command."
+	if reporter != nil {
+		message = reporter.TranslateReply(userID, message)
+	}
+
+	b.BotRespond(s, i, message)
+}
+
+func (b *Bot) InitCommands() {
+	session := b.DiscordSession
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+
+	session.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		if i.Type == discordgo.InteractionMessageComponent {
+			b.HandleComponent(s, i)
+			return
+		}
+
+		if i.Type != discordgo.InteractionApplicationCommand {
+			return
+		}
+
+		commandName := i.ApplicationCommandData().Name
+
+		if command, ok := b.Commands[commandName]; ok {
+			command.Handler(s, i)
+		}
+	})
+
+	registeredCommands, err := session.ApplicationCommands(session.State.User.ID, b.Guild)
+	if err != nil {
+		b.Logger.Error().Err(err).Msg("Could not fetch registered commands")
+		return
+	}
+
+	desiredCommands := utils.Map(
+		utils.MapToArray(b.Commands),
+		func(c *Command) *discordgo.ApplicationCommand { return c.Info },
+	)
+
+	commandsToAdd := utils.Subtract(desiredCommands, registeredCommands, func(v *discordgo.ApplicationCommand) string {
+		return v.Name
+	})
+
+	commandsToDelete := utils.Subtract(registeredCommands, desiredCommands, func(v *discordgo.ApplicationCommand) string {
+		return v.Name
+	})
+
+	commandsToUpdate := utils.Union(registeredCommands, desiredCommands, func(v *discordgo.ApplicationCommand) string {
+		return v.Name
+	})
+
+	b.Logger.Info().
+		Int("commands_to_add", len(commandsToAdd)).
+		Int("commands_to_delete", len(commandsToDelete)).
+		Int("commands_to_update", len(commandsToUpdate)).
+		Msg("Updating Discord slash commands")
+
+	wg.Add(len(commandsToAdd) + len(commandsToDelete) + len(commandsToUpdate))
+
+	for _, command := range commandsToDelete {
+		go func(command *discordgo.ApplicationCommand) {
+			defer wg.Done()
+
+			err := session.ApplicationCommandDelete(session.State.User.ID, b.Guild, command.ID)
+			if err != nil {
+				b.Logger.Error().Err(err).Str("command", command.Name).Msg("Could not delete command")
+				return
+			}
+			b.Logger.Info().Str("command", command.Name).Msg("Deleted command")
+		}(command)
+	}
+
+	for _, command := range commandsToAdd {
+		go func(command *discordgo.ApplicationCommand) {
+			defer wg.Done()
+
+			cmd, err := session.ApplicationCommandCreate(session.State.User.ID, b.Guild, command)
+			if err != nil {
+				b.Logger.Error().Err(err).Str("command", command.Name).Msg("Could not create command")
+				return
+			}
+			b.Logger.Info().Str("command", cmd.Name).Msg("Created command")
+
+			mutex.Lock()
+			b.Commands[command.Name].Info = cmd
+			mutex.Unlock()
+		}(command)
+	}
+
+	for _, command := range commandsToUpdate {
+		go func(command *discordgo.ApplicationCommand) {
+			defer wg.Done()
+
+			cmd, err := session.ApplicationCommandEdit(
+				session.State.User.ID,
+				b.Guild,
+				command.ID,
+				command,
+			)
+			if err != nil {
+				b.Logger.Error().Err(err).Str("command", command.Name).Msg("Could not update command")
+				return
+			}
+			b.Logger.Info().Str("command", cmd.Name).Msg("Updated command")
+
+			mutex.Lock()
+			b.Commands[command.Name].Info = cmd
+			mutex.Unlock()
+		}(command)
+	}
+
+	wg.Wait()
+	b.Logger.Info().Msg("All commands updated")
+}
+
+func (b *Bot) BotRespond(s *discordgo.Session, i *discordgo.InteractionCreate, text string) {
+	chunks := utils.SplitStringIntoChunks(text, 2000)
+	firstChunk, rest := chunks[0], chunks[1:]
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: firstChunk,
+		},
+	}); err != nil {
+		b.Logger.Error().Err(err).Msg("Error sending response")
+	}
+
+	for index, chunk := range rest {
+		if _, err := s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
+			Content: chunk,
+		}); err != nil {
+			b.Logger.Error().
+				Int("chunk", index).
+				Err(err).
+				Msg("Error sending followup message")
+		}
+	}
+}
+
+func GetOptionValue(i *discordgo.InteractionCreate, name string) string {
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == name {
+			value, _ := option.Value.(string)
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,96 @@
+package discord
+
+import (
+	"bytes"
+	chartPkg "main/pkg/chart"
+	"main/pkg/constants"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (reporter *Reporter) GetChartCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "chart",
+			Description: "Get the chart of validator's signed and missed blocks",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "address",
+					Description: "Validator address",
+					Required:    true,
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "chart")
+
+			address := GetOptionValue(i, "address")
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					GetUserID(i),
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			chart := chartPkg.NewBlocksChart(
+				reporter.Manager.GetValidatorBlocksSignatures(validator),
+				reporter.Config.GetBlocksMissCount(),
+			)
+			if int64(len(chart.Signatures))-chart.Count(constants.BlockStatusUnknown) < 2 {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					GetUserID(i),
+					"Not enough blocks on %s to draw a chart yet, try again later.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			image, err := chart.Render()
+			if err != nil {
+				reporter.Logger.Error().Err(err).Msg("Could not render chart")
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Could not render chart"))
+				return
+			}
+
+			render := chartRender{
+				Config:             reporter.Config,
+				Link:               reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+				BlocksCount:        len(chart.Signatures),
+				Signed:             chart.Count(constants.BlockStatusSigned),
+				Missed:             chart.Count(constants.BlockStatusMissed),
+				NotActive:          chart.Count(constants.BlockStatusNotActive),
+				MissedBlocksToJail: chart.MissedBlocksToJail,
+			}
+
+			if render.Missed < render.MissedBlocksToJail {
+				render.TimeToJail = reporter.Manager.GetTimeTillJail(render.Missed)
+			}
+
+			caption, err := reporter.GetUserTemplatesManager(GetUserID(i)).Render("Chart", render)
+			if err != nil {
+				reporter.Logger.Error().Err(err).Msg("Error rendering chart")
+				return
+			}
+
+			if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: caption,
+					Files: []*discordgo.File{{
+						Name:        "chart.png",
+						ContentType: "image/png",
+						Reader:      bytes.NewReader(image),
+					}},
+				},
+			}); err != nil {
+				reporter.Logger.Error().Err(err).Msg("Error sending chart")
+			}
+		},
+	}
+}
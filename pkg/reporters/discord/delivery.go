@@ -0,0 +1,79 @@
+package discord
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (reporter *Reporter) GetDeliveryCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "delivery",
+			Description: "Choose how to receive notifications",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "mode",
+					Description: "Delivery mode",
+					Required:    true,
+					Choices: utils.Map(
+						constants.GetDeliveryModes(),
+						func(mode constants.DeliveryMode) *discordgo.ApplicationCommandOptionChoice {
+							return &discordgo.ApplicationCommandOptionChoice{
+								Name:  string(mode),
+								Value: string(mode),
+							}
+						},
+					),
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "delivery")
+
+			mode := GetOptionValue(i, "mode")
+
+			user := i.User
+			if user == nil {
+				user = i.Member.User
+			}
+			if user == nil {
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			deliveryMode := constants.DeliveryMode(mode)
+			if !utils.Contains(constants.GetDeliveryModes(), deliveryMode) {
+				reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "Unknown delivery mode: %s", SerializeCode(mode)))
+				return
+			}
+
+			if !reporter.Manager.SetNotifierDeliveryMode(reporter.Name(), user.ID, deliveryMode) {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			response := reporter.TranslateReply(
+				user.ID,
+				"Delivery mode on %s is set to %s.",
+				reporter.Config.GetName(),
+				SerializeCode(string(deliveryMode)),
+			)
+
+			if deliveryMode != constants.DeliveryModeChannel {
+				response += "\n" + reporter.TranslateReply(
+					user.ID,
+					"Make sure you allow direct messages from server members, otherwise the bot won't be able to message you.",
+				)
+			}
+
+			reporter.BotRespond(s, i, response)
+		},
+	}
+}
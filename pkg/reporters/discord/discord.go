@@ -1,17 +1,18 @@
 package discord
 
 import (
+	"errors"
+	"fmt"
 	"main/pkg/config"
 	"main/pkg/constants"
 	"main/pkg/events"
+	"main/pkg/i18n"
 	"main/pkg/metrics"
 	snapshotPkg "main/pkg/snapshot"
 	statePkg "main/pkg/state"
 	templatesPkg "main/pkg/templates"
 	types "main/pkg/types"
-	"main/pkg/utils"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -19,13 +20,9 @@ import (
 )
 
 type Reporter struct {
-	Token   string
-	Guild   string
-	Channel string
+	Destinations []config.DiscordDestination
 
-	Version string
-
-	DiscordSession   *discordgo.Session
+	Bot              *Bot
 	Logger           zerolog.Logger
 	Config           *config.ChainConfig
 	Manager          *statePkg.Manager
@@ -42,44 +39,29 @@ func NewReporter(
 	manager *statePkg.Manager,
 	metricsManager *metrics.Manager,
 	snapshotManager *snapshotPkg.Manager,
+	bot *Bot,
 ) *Reporter {
-	return &Reporter{
-		Token:            chainConfig.DiscordConfig.Token,
-		Guild:            chainConfig.DiscordConfig.Guild,
-		Channel:          chainConfig.DiscordConfig.Channel,
+	if bot == nil && chainConfig.DiscordConfig.Token != "" && chainConfig.DiscordConfig.Guild != "" {
+		bot = NewBot(
+			chainConfig.DiscordConfig.Token,
+			chainConfig.DiscordConfig.Guild,
+			chainConfig.DiscordConfig.DiscordPermissions,
+			version,
+			logger,
+		)
+	}
+
+	reporter := &Reporter{
+		Bot:              bot,
+		Destinations:     chainConfig.DiscordConfig.GetDestinations(),
 		Config:           chainConfig,
 		Logger:           logger.With().Str("component", "discord_reporter").Logger(),
 		Manager:          manager,
 		MetricsManager:   metricsManager,
 		SnapshotManager:  snapshotManager,
-		TemplatesManager: templatesPkg.NewManager(logger, constants.DiscordReporterName),
-		Commands:         make(map[string]*Command, 0),
-		Version:          version,
-	}
-}
-
-func (reporter *Reporter) Init() {
-	if !reporter.Enabled() {
-		reporter.Logger.Debug().Msg("Discord credentials not set, not creating Discord reporter")
-		return
-	}
-	session, err := discordgo.New("Bot " + reporter.Token)
-	if err != nil {
-		reporter.Logger.Warn().Err(err).Msg("Error initializing Discord bot")
-		return
-	}
-
-	reporter.DiscordSession = session
-
-	// Open a websocket connection to Discord and begin listening.
-	err = session.Open()
-	if err != nil {
-		reporter.Logger.Warn().Err(err).Msg("Error opening Discord websocket session")
-		return
+		TemplatesManager: templatesPkg.NewManager(logger, constants.DiscordReporterName, chainConfig.TemplatesDir, chainConfig.Language),
 	}
 
-	reporter.Logger.Info().Err(err).Msg("Discord bot listening")
-
 	reporter.Commands = map[string]*Command{
 		"params":      reporter.GetParamsCommand(),
 		"missing":     reporter.GetMissingCommand(),
@@ -87,119 +69,65 @@ func (reporter *Reporter) Init() {
 		"subscribe":   reporter.GetSubscribeCommand(),
 		"unsubscribe": reporter.GetUnsubscribeCommand(),
 		"status":      reporter.GetStatusCommand(),
-		"help":        reporter.GetHelpCommand(),
 		"notifiers":   reporter.GetNotifiersCommand(),
+		"chart":       reporter.GetChartCommand(),
+		"delivery":    reporter.GetDeliveryCommand(),
+		"lang":        reporter.GetLanguageCommand(),
+		"mute":        reporter.GetMuteCommand(),
+		"unmute":      reporter.GetUnmuteCommand(),
+		"quiet":       reporter.GetQuietHoursCommand(),
+		"maintenance": reporter.GetMaintenanceCommand(),
 	}
 
-	for query := range reporter.Commands {
-		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, query)
+	if bot != nil && len(reporter.Destinations) > 0 {
+		bot.AddReporter(reporter)
 	}
 
-	go reporter.InitCommands()
+	return reporter
 }
 
-func (reporter *Reporter) InitCommands() {
-	session := reporter.DiscordSession
-	var wg sync.WaitGroup
-	var mutex sync.Mutex
-
-	session.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
-		commandName := i.ApplicationCommandData().Name
-
-		if command, ok := reporter.Commands[commandName]; ok {
-			command.Handler(s, i)
-		}
-	})
-
-	registeredCommands, err := session.ApplicationCommands(session.State.User.ID, reporter.Guild)
-	if err != nil {
-		reporter.Logger.Error().Err(err).Msg("Could not fetch registered commands")
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("Discord credentials not set, not creating Discord reporter")
 		return
 	}
-
-	desiredCommands := utils.Map(
-		utils.MapToArray(reporter.Commands),
-		func(c *Command) *discordgo.ApplicationCommand { return c.Info },
-	)
-
-	commandsToAdd := utils.Subtract(desiredCommands, registeredCommands, func(v *discordgo.ApplicationCommand) string {
-		return v.Name
-	})
-
-	commandsToDelete := utils.Subtract(registeredCommands, desiredCommands, func(v *discordgo.ApplicationCommand) string {
-		return v.Name
-	})
-
-	commandsToUpdate := utils.Union(registeredCommands, desiredCommands, func(v *discordgo.ApplicationCommand) string {
-		return v.Name
-	})
-
-	reporter.Logger.Info().
-		Int("commands_to_add", len(commandsToAdd)).
-		Int("commands_to_delete", len(commandsToDelete)).
-		Int("commands_to_update", len(commandsToUpdate)).
-		Msg("Updating Discord slash commands")
-
-	wg.Add(len(commandsToAdd) + len(commandsToDelete) + len(commandsToUpdate))
-
-	for _, command := range commandsToDelete {
-		go func(command *discordgo.ApplicationCommand) {
-			defer wg.Done()
-
-			err := session.ApplicationCommandDelete(session.State.User.ID, reporter.Guild, command.ID)
-			if err != nil {
-				reporter.Logger.Error().Err(err).Str("command", command.Name).Msg("Could not delete command")
-				return
-			}
-			reporter.Logger.Info().Str("command", command.Name).Msg("Deleted command")
-		}(command)
+	for query := range reporter.Commands {
+		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, query)
 	}
 
-	for _, command := range commandsToAdd {
-		go func(command *discordgo.ApplicationCommand) {
-			defer wg.Done()
-
-			cmd, err := session.ApplicationCommandCreate(session.State.User.ID, reporter.Guild, command)
-			if err != nil {
-				reporter.Logger.Error().Err(err).Str("command", command.Name).Msg("Could not create command")
-				return
-			}
-			reporter.Logger.Info().Str("command", cmd.Name).Msg("Created command")
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "help")
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "ack")
 
-			mutex.Lock()
-			reporter.Commands[command.Name].Info = cmd
-			mutex.Unlock()
-		}(command)
-	}
+	reporter.Bot.Start()
+}
 
-	for _, command := range commandsToUpdate {
-		go func(command *discordgo.ApplicationCommand) {
-			defer wg.Done()
+func (reporter *Reporter) Enabled() bool {
+	return reporter.Bot != nil && len(reporter.Destinations) > 0
+}
 
-			cmd, err := session.ApplicationCommandEdit(
-				session.State.User.ID,
-				reporter.Guild,
-				command.ID,
-				command,
-			)
-			if err != nil {
-				reporter.Logger.Error().Err(err).Str("command", command.Name).Msg("Could not update command")
-				return
-			}
-			reporter.Logger.Info().Str("command", cmd.Name).Msg("Updated command")
+// GetUserTemplatesManager returns the templates manager rendering replies in the language
+// the user has chosen, or in the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserTemplatesManager(userID string) templatesPkg.Manager {
+	return reporter.TemplatesManager.WithLanguage(reporter.Manager.GetNotifierLanguage(reporter.Name(), userID))
+}
 
-			mutex.Lock()
-			reporter.Commands[command.Name].Info = cmd
-			mutex.Unlock()
-		}(command)
+// GetUserLocalizer returns the localizer for the language the user has chosen,
+// or for the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserLocalizer(userID string) *i18n.Localizer {
+	if language := reporter.Manager.GetNotifierLanguage(reporter.Name(), userID); language != "" {
+		return i18n.GetLocalizer(language)
 	}
 
-	wg.Wait()
-	reporter.Logger.Info().Msg("All commands updated")
+	return i18n.GetLocalizer(reporter.Config.Language)
 }
 
-func (reporter *Reporter) Enabled() bool {
-	return reporter.Token != "" && reporter.Guild != "" && reporter.Channel != ""
+// TranslateReply translates the reply into the user language.
+func (reporter *Reporter) TranslateReply(userID string, message string, args ...any) string {
+	return reporter.GetUserLocalizer(userID).Translate(message, args...)
+}
+
+func SerializeCode(value string) string {
+	return "`" + value + "`"
 }
 
 func (reporter *Reporter) Name() constants.ReporterName {
@@ -208,11 +136,9 @@ func (reporter *Reporter) Name() constants.ReporterName {
 
 func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
 	validator := event.GetValidator()
-	notifiers := reporter.Manager.GetNotifiersForReporter(validator.OperatorAddress, constants.DiscordReporterName)
-
 	eventToRender := types.RenderEventItem{
 		Event:         event,
-		Notifiers:     notifiers,
+		Notifiers:     reporter.Manager.GetNotifiersToMention(event, constants.DiscordReporterName),
 		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
 	}
 
@@ -220,55 +146,132 @@ func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEv
 		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
 	}
 
+	if incident, found := reporter.Manager.GetIncident(validator.OperatorAddress); found {
+		eventToRender.Incident = incident
+	}
+
 	return eventToRender
 }
 
 func (reporter *Reporter) Send(report *types.Report) error {
 	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
 
-	var sb strings.Builder
-
-	for _, event := range report.Events {
-		eventToRender := reporter.SerializeEvent(event)
-		sb.WriteString(reporter.TemplatesManager.SerializeEvent(eventToRender) + "\n")
+	if reporter.Bot.DiscordSession == nil {
+		return errors.New("discord bot is not initialized")
 	}
 
-	reportString := sb.String()
+	var errs []error
 
-	reporter.Logger.Trace().Str("report", reportString).Msg("Sending a report")
+	for _, destination := range reporter.Destinations {
+		destinationEvents := make([]types.ReportEvent, 0)
 
-	_, err := reporter.DiscordSession.ChannelMessageSend(
-		reporter.Channel,
-		reportString,
-	)
-	return err
-}
+		for _, event := range destination.Filter(report.Events) {
+			message, ok := reporter.Manager.GetIncidentMessage(event, reporter.Name(), destination.Channel)
+			if !ok {
+				destinationEvents = append(destinationEvents, event)
+				continue
+			}
 
-func (reporter *Reporter) BotRespond(s *discordgo.Session, i *discordgo.InteractionCreate, text string) {
-	chunks := utils.SplitStringIntoChunks(text, 2000)
-	firstChunk, rest := chunks[0], chunks[1:]
-
-	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Content: firstChunk,
-		},
-	}); err != nil {
-		reporter.Logger.Error().Err(err).Msg("Error sending response")
+			if err := report.Deliver("incident:"+destination.Channel+":"+message.OperatorAddress, func() (string, error) {
+				return reporter.SerializeIncidentMessage(message, event), nil
+			}, func(text string) error {
+				return reporter.SendIncidentMessage(destination, message, event, text)
+			}); err != nil {
+				reporter.Logger.Error().
+					Err(err).
+					Str("channel", destination.Channel).
+					Msg("Could not send Discord incident message")
+				errs = append(errs, err)
+			}
+		}
+
+		if len(destinationEvents) == 0 {
+			continue
+		}
+
+		if err := report.Deliver("channel:"+destination.Channel, func() (string, error) {
+			var sb strings.Builder
+
+			if reporter.Bot.IsShared() {
+				sb.WriteString(fmt.Sprintf("**%s**\n", reporter.Config.GetName()))
+			}
+
+			for _, event := range destinationEvents {
+				eventToRender := reporter.SerializeEvent(event)
+				sb.WriteString(reporter.TemplatesManager.SerializeEvent(eventToRender) + "\n")
+			}
+
+			return sb.String(), nil
+		}, func(reportString string) error {
+			reporter.Logger.Trace().
+				Str("channel", destination.Channel).
+				Str("report", reportString).
+				Msg("Sending a report")
+
+			_, err := reporter.Bot.DiscordSession.ChannelMessageSendComplex(
+				destination.Channel,
+				&discordgo.MessageSend{
+					Content:    reportString,
+					Components: reporter.GetAckComponents(destinationEvents),
+				},
+			)
+			return err
+		}); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("channel", destination.Channel).
+				Msg("Could not send Discord message")
+			errs = append(errs, err)
+		}
 	}
 
-	for index, chunk := range rest {
-		if _, err := s.FollowupMessageCreate(i.Interaction, false, &discordgo.WebhookParams{
-			Content: chunk,
+	for _, message := range reporter.Manager.GetDirectMessages(report, reporter.Name()) {
+		if err := report.Deliver("dm:"+message.UserID, func() (string, error) {
+			return reporter.SerializeDirectMessage(message), nil
+		}, func(content string) error {
+			return reporter.SendDirectMessage(message.UserID, content)
 		}); err != nil {
 			reporter.Logger.Error().
-				Int("chunk", index).
 				Err(err).
-				Msg("Error sending followup message")
+				Str("user", message.UserName).
+				Msg("Could not send Discord direct message")
+			errs = append(errs, err)
 		}
 	}
+
+	return errors.Join(errs...)
+}
+
+func (reporter *Reporter) SerializeDirectMessage(message *types.DirectMessage) string {
+	manager := reporter.TemplatesManager.WithLanguage(message.Language)
+
+	var sb strings.Builder
+
+	sb.WriteString(manager.Translate("Updates on %s for validators you are subscribed to:", reporter.Config.GetName()) + "\n")
+
+	for _, event := range message.Events {
+		eventToRender := reporter.SerializeEvent(event)
+		eventToRender.Notifiers = nil
+		sb.WriteString(manager.SerializeEvent(eventToRender) + "\n")
+	}
+
+	return sb.String()
+}
+
+func (reporter *Reporter) SendDirectMessage(userID string, content string) error {
+	channel, err := reporter.Bot.DiscordSession.UserChannelCreate(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = reporter.Bot.DiscordSession.ChannelMessageSend(channel.ID, content)
+	return err
+}
+
+func (reporter *Reporter) BotRespond(s *discordgo.Session, i *discordgo.InteractionCreate, text string) {
+	reporter.Bot.BotRespond(s, i, text)
 }
 
 func (reporter *Reporter) SerializeDate(date time.Time) string {
-	return date.Format(time.RFC822)
+	return reporter.TemplatesManager.SerializeDate(date)
 }
@@ -6,25 +6,32 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-func (reporter *Reporter) GetHelpCommand() *Command {
+func (b *Bot) GetHelpCommand() *Command {
 	return &Command{
 		Info: &discordgo.ApplicationCommand{
 			Name:        "help",
 			Description: "Get the bot help",
 		},
 		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
-			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "help")
+			for _, reporter := range b.Reporters {
+				reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "help")
+			}
+
+			render := helpRender{
+				Version:  b.Version,
+				Commands: b.Commands,
+			}
+			if b.IsShared() {
+				render.Chains = b.GetChainNames()
+			}
 
-			template, err := reporter.TemplatesManager.Render("Help", helpRender{
-				Version:  reporter.Version,
-				Commands: reporter.Commands,
-			})
+			template, err := b.Reporters[0].GetUserTemplatesManager(GetUserID(i)).Render("Help", render)
 			if err != nil {
-				reporter.Logger.Error().Err(err).Str("template", "help").Msg("Error rendering template")
+				b.Logger.Error().Err(err).Str("template", "help").Msg("Error rendering template")
 				return
 			}
 
-			reporter.BotRespond(s, i, template)
+			b.BotRespond(s, i, template)
 		},
 	}
 }
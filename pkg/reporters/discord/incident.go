@@ -0,0 +1,156 @@
+package discord
+
+import (
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/types"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// Discord limits thread names to 100 characters.
+	MaxThreadNameLength = 100
+	// Archive threads after a week of inactivity, if they were not archived on recovery.
+	ThreadAutoArchiveDuration = 10080
+)
+
+// SendIncidentMessage edits the message of the validator's ongoing incident,
+// or posts a new one if there is none yet or it cannot be edited.
+// If the destination has incident threads enabled, a thread is started off the incident message,
+// receiving each following step of the incident, and is archived once the incident is over.
+func (reporter *Reporter) SendIncidentMessage(
+	destination config.DiscordDestination,
+	message *types.IncidentMessage,
+	event types.ReportEvent,
+	text string,
+) error {
+	channel := destination.Channel
+	components := reporter.GetAckComponents([]types.ReportEvent{event})
+
+	if !message.IsNew() {
+		_, err := reporter.Bot.DiscordSession.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:         message.MessageID,
+			Channel:    channel,
+			Content:    &text,
+			Components: components,
+		})
+		if err == nil {
+			reporter.MaybeSendToIncidentThread(message, event)
+			return reporter.Manager.SaveIncidentMessage(message)
+		}
+
+		reporter.Logger.Warn().
+			Err(err).
+			Str("channel", channel).
+			Str("message", message.MessageID).
+			Msg("Could not edit incident message, sending a new one")
+	}
+
+	sent, err := reporter.Bot.DiscordSession.ChannelMessageSendComplex(channel, &discordgo.MessageSend{
+		Content:    text,
+		Components: components,
+	})
+	if err != nil {
+		return err
+	}
+
+	message.MessageID = sent.ID
+
+	switch {
+	case message.ThreadID != "":
+		reporter.MaybeSendToIncidentThread(message, event)
+	case destination.IncidentThreads && !message.Resolved:
+		thread, err := reporter.Bot.DiscordSession.MessageThreadStart(
+			channel,
+			sent.ID,
+			reporter.GetIncidentThreadName(event),
+			ThreadAutoArchiveDuration,
+		)
+		if err != nil {
+			reporter.Logger.Warn().
+				Err(err).
+				Str("channel", channel).
+				Msg("Could not start incident thread")
+		} else {
+			message.ThreadID = thread.ID
+		}
+	}
+
+	return reporter.Manager.SaveIncidentMessage(message)
+}
+
+// MaybeSendToIncidentThread posts the incident step into its thread, if there is one,
+// and archives the thread once the incident is over.
+func (reporter *Reporter) MaybeSendToIncidentThread(message *types.IncidentMessage, event types.ReportEvent) {
+	if message.ThreadID == "" {
+		return
+	}
+
+	text := reporter.TemplatesManager.SerializeEvent(reporter.SerializeEvent(event))
+	if message.Resolved {
+		emoji, line := message.GetClosingLine()
+		text += "\n**" + emoji + " " + reporter.TemplatesManager.Translate(line) + "**"
+	}
+
+	if _, err := reporter.Bot.DiscordSession.ChannelMessageSend(message.ThreadID, text); err != nil {
+		reporter.Logger.Warn().
+			Err(err).
+			Str("thread", message.ThreadID).
+			Msg("Could not send message to incident thread")
+	}
+
+	if !message.Resolved {
+		return
+	}
+
+	archived := true
+	if _, err := reporter.Bot.DiscordSession.ChannelEditComplex(message.ThreadID, &discordgo.ChannelEdit{
+		Archived: &archived,
+	}); err != nil {
+		reporter.Logger.Warn().
+			Err(err).
+			Str("thread", message.ThreadID).
+			Msg("Could not archive incident thread")
+	}
+}
+
+func (reporter *Reporter) GetIncidentThreadName(event types.ReportEvent) string {
+	validator := event.GetValidator()
+
+	name := []rune(fmt.Sprintf("Incident: %s", validator.Moniker))
+	if reporter.Bot.IsShared() {
+		name = []rune(fmt.Sprintf("Incident: %s on %s", validator.Moniker, reporter.Config.GetName()))
+	}
+
+	if len(name) > MaxThreadNameLength {
+		name = name[:MaxThreadNameLength]
+	}
+
+	return string(name)
+}
+
+func (reporter *Reporter) SerializeIncidentMessage(
+	message *types.IncidentMessage,
+	event types.ReportEvent,
+) string {
+	var sb strings.Builder
+
+	if reporter.Bot.IsShared() {
+		sb.WriteString(fmt.Sprintf("**%s**\n", reporter.Config.GetName()))
+	}
+
+	sb.WriteString(reporter.TemplatesManager.SerializeEvent(reporter.SerializeEvent(event)) + "\n")
+	sb.WriteString(reporter.TemplatesManager.Translate(
+		"Progression: %s",
+		message.SerializeProgression(reporter.TemplatesManager.Translate),
+	))
+
+	if message.Resolved {
+		emoji, line := message.GetClosingLine()
+		sb.WriteString("\n**" + emoji + " " + reporter.TemplatesManager.Translate(line) + "**")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,70 @@
+package discord
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (reporter *Reporter) GetLanguageCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "lang",
+			Description: "Choose the language of the bot replies and notifications",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "language",
+					Description: "Language",
+					Required:    true,
+					Choices: utils.Map(
+						constants.GetLanguages(),
+						func(language constants.Language) *discordgo.ApplicationCommandOptionChoice {
+							return &discordgo.ApplicationCommandOptionChoice{
+								Name:  string(language),
+								Value: string(language),
+							}
+						},
+					),
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "lang")
+
+			value := GetOptionValue(i, "language")
+
+			user := i.User
+			if user == nil {
+				user = i.Member.User
+			}
+			if user == nil {
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			language := constants.Language(value)
+			if !utils.Contains(constants.GetLanguages(), language) {
+				reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "Unknown language: %s", SerializeCode(value)))
+				return
+			}
+
+			manager := reporter.TemplatesManager.WithLanguage(language)
+
+			if !reporter.Manager.SetNotifierLanguage(reporter.Name(), user.ID, language) {
+				reporter.BotRespond(s, i, manager.Translate(
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			reporter.BotRespond(s, i, manager.Translate(
+				"Language on %s is set to %s.",
+				reporter.Config.GetName(),
+				language,
+			))
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package discord
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (reporter *Reporter) GetMaintenanceCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "maintenance",
+			Description: "See or manage validators' maintenance windows",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "address",
+					Description: "Validator address, omit to see all maintenance windows",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "period",
+					Description: "Duration like 2h, blocks range like 100000-100500, or \"off\" to cancel",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "start",
+					Description: "Start time for a duration, like 2006-01-02T15:04:05Z, defaults to now",
+					Required:    false,
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "maintenance")
+
+			address := GetOptionValue(i, "address")
+			period := GetOptionValue(i, "period")
+			start := GetOptionValue(i, "start")
+
+			if address == "" {
+				reporter.BotRespond(s, i, reporter.SerializeMaintenanceWindows(GetUserID(i)))
+				return
+			}
+
+			if i.Member == nil || i.Member.Permissions&discordgo.PermissionAdministrator == 0 {
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Only server administrators can manage maintenance windows."))
+				return
+			}
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					GetUserID(i),
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			if period == "off" {
+				if !reporter.Manager.RemoveMaintenanceWindow(address) {
+					reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "This validator has no maintenance window"))
+					return
+				}
+
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					GetUserID(i),
+					"Cancelled maintenance window on %s: %s",
+					reporter.Config.GetName(),
+					validatorLinkSerialized,
+				))
+				return
+			}
+
+			args := []string{period}
+			if start != "" {
+				args = append(args, start)
+			}
+
+			window, err := types.ParseMaintenanceWindow(address, args, time.Now())
+			if err != nil {
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Could not parse maintenance window: %s", err))
+				return
+			}
+
+			window.CreatedBy = i.Member.User.Username
+
+			if err := reporter.Manager.SetMaintenanceWindow(window); err != nil {
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Could not save maintenance window"))
+				return
+			}
+
+			reporter.BotRespond(s, i, reporter.TranslateReply(
+				GetUserID(i),
+				"Declared maintenance window on %s for %s: %s",
+				reporter.Config.GetName(),
+				validatorLinkSerialized,
+				SerializeCode(window.String()),
+			))
+		},
+	}
+}
+
+func (reporter *Reporter) SerializeMaintenanceWindows(userID string) string {
+	windows := reporter.Manager.GetMaintenanceWindows()
+	if len(windows) == 0 {
+		return reporter.TranslateReply(userID, "There are no maintenance windows on %s.", reporter.Config.GetName())
+	}
+
+	var sb strings.Builder
+	sb.WriteString("**" + reporter.TranslateReply(userID, "Maintenance windows on %s:", reporter.Config.GetName()) + "**\n")
+
+	for _, window := range windows {
+		link := SerializeCode(window.OperatorAddress)
+		if validator, found := reporter.Manager.GetValidator(window.OperatorAddress); found {
+			link = string(reporter.TemplatesManager.SerializeLink(reporter.Config.ExplorerConfig.GetValidatorLink(validator)))
+		}
+
+		sb.WriteString(reporter.TranslateReply(userID, "- %s: %s (by %s)", link, window, window.CreatedBy) + "\n")
+	}
+
+	return sb.String()
+}
@@ -23,7 +23,7 @@ func (reporter *Reporter) GetMissingCommand() *Command {
 			if !found {
 				reporter.Logger.Info().
 					Msg("No older snapshot on discord missing query!")
-				reporter.BotRespond(s, i, "Error getting validators list")
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Error getting validators list"))
 				return
 			}
 
@@ -60,7 +60,7 @@ func (reporter *Reporter) GetMissingCommand() *Command {
 				}),
 			}
 
-			template, err := reporter.TemplatesManager.Render("Missing", render)
+			template, err := reporter.GetUserTemplatesManager(GetUserID(i)).Render("Missing", render)
 			if err != nil {
 				reporter.Logger.Error().Err(err).Msg("Error rendering missing")
 				return
@@ -0,0 +1,159 @@
+package discord
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (reporter *Reporter) GetMuteCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "mute",
+			Description: "Mute validator's updates for some time",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "address",
+					Description: "Validator address",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "duration",
+					Description: "Duration, like 30m, 2h or 1d",
+					Required:    true,
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "mute")
+
+			address := GetOptionValue(i, "address")
+			durationString := GetOptionValue(i, "duration")
+
+			user := i.User
+			if user == nil {
+				user = i.Member.User
+			}
+			if user == nil {
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			duration, err := utils.ParseDuration(durationString)
+			if err != nil || duration <= 0 {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Invalid duration %s, use values like 30m, 2h or 1d",
+					SerializeCode(durationString),
+				))
+				return
+			}
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			if !reporter.Manager.MuteNotifier(address, reporter.Name(), user.ID, time.Now().Add(duration)) {
+				reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "You are not subscribed to this validator's notifications"))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			reporter.BotRespond(s, i, reporter.TranslateReply(
+				user.ID,
+				"Muted validator's notifications on %s for %s: %s",
+				reporter.Config.GetName(),
+				reporter.GetUserLocalizer(user.ID).FormatDuration(duration),
+				validatorLinkSerialized,
+			))
+		},
+	}
+}
+
+func (reporter *Reporter) GetUnmuteCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "unmute",
+			Description: "Unmute validator's updates",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "address",
+					Description: "Validator address, omit to unmute all validators",
+					Required:    false,
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "unmute")
+
+			address := GetOptionValue(i, "address")
+
+			user := i.User
+			if user == nil {
+				user = i.Member.User
+			}
+			if user == nil {
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			if address == "" {
+				if !reporter.Manager.UnmuteNotifier("", reporter.Name(), user.ID) {
+					reporter.BotRespond(s, i, reporter.TranslateReply(
+						user.ID,
+						"You are not subscribed to any validator's notifications on %s.",
+						reporter.Config.GetName(),
+					))
+					return
+				}
+
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Unmuted all validators' notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			if !reporter.Manager.UnmuteNotifier(address, reporter.Name(), user.ID) {
+				reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "You are not subscribed to this validator's notifications"))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			reporter.BotRespond(s, i, reporter.TranslateReply(
+				user.ID,
+				"Unmuted validator's notifications on %s: %s",
+				reporter.Config.GetName(),
+				validatorLinkSerialized,
+			))
+		},
+	}
+}
@@ -32,12 +32,12 @@ func (reporter *Reporter) GetNotifiersCommand() *Command {
 				})
 			}
 
-			template, err := reporter.TemplatesManager.Render("Notifiers", notifierRender{
+			template, err := reporter.GetUserTemplatesManager(GetUserID(i)).Render("Notifiers", notifierRender{
 				Entries: entries,
 				Config:  reporter.Config,
 			})
 			if err != nil {
-				reporter.BotRespond(s, i, "Error rendering notifiers template")
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Error rendering notifiers template"))
 				return
 			}
 
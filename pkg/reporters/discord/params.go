@@ -21,16 +21,17 @@ func (reporter *Reporter) GetParamsCommand() *Command {
 			snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
 			if !found {
 				reporter.Logger.Info().Msg("No older snapshot on telegram params query!")
-				reporter.BotRespond(s, i, "Error getting params")
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Error getting params"))
 				return
 			}
 
 			activeValidators := snapshot.Entries.GetActive()
-			template, err := reporter.TemplatesManager.Render("Params", paramsRender{
+			template, err := reporter.GetUserTemplatesManager(GetUserID(i)).Render("Params", paramsRender{
 				Config:          reporter.Config,
 				BlockTime:       blockTime,
 				MaxTimeToJail:   maxTimeToJail,
 				ValidatorsCount: len(activeValidators),
+				Localizer:       reporter.GetUserLocalizer(GetUserID(i)),
 			})
 			if err != nil {
 				reporter.Logger.Error().Err(err).Msg("Error rendering params template")
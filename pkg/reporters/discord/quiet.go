@@ -0,0 +1,90 @@
+package discord
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (reporter *Reporter) GetQuietHoursCommand() *Command {
+	return &Command{
+		Info: &discordgo.ApplicationCommand{
+			Name:        "quiet",
+			Description: "Set quiet hours when you are not mentioned, or show the current ones",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "start",
+					Description: "Start time, like 22:00, or \"off\" to disable quiet hours",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "end",
+					Description: "End time, like 07:00",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "timezone",
+					Description: "Timezone, like Europe/Berlin",
+					Required:    false,
+				},
+			},
+		},
+		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "quiet")
+
+			start := GetOptionValue(i, "start")
+			end := GetOptionValue(i, "end")
+			timezone := GetOptionValue(i, "timezone")
+
+			user := i.User
+			if user == nil {
+				user = i.Member.User
+			}
+			if user == nil {
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			if start == "" {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Your current quiet hours on %s: %s.",
+					reporter.Config.GetName(),
+					SerializeCode(reporter.Manager.GetNotifierQuietHours(reporter.Name(), user.ID).String()),
+				))
+				return
+			}
+
+			var quietHours types.QuietHours
+			if start != "off" {
+				parsed, err := types.ParseQuietHours(start, end, timezone)
+				if err != nil {
+					reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "Could not set quiet hours: %s", err))
+					return
+				}
+
+				quietHours = parsed
+			}
+
+			if !reporter.Manager.SetNotifierQuietHours(reporter.Name(), user.ID, quietHours) {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			reporter.BotRespond(s, i, reporter.TranslateReply(
+				user.ID,
+				"Quiet hours on %s are set to %s.",
+				reporter.Config.GetName(),
+				SerializeCode(quietHours.String()),
+			))
+		},
+	}
+}
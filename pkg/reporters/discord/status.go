@@ -1,10 +1,12 @@
 package discord
 
 import (
+	"errors"
 	"fmt"
 	"main/pkg/constants"
 	"main/pkg/utils"
 	"sort"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -27,8 +29,14 @@ func (reporter *Reporter) GetStatusCommand() *Command {
 				return
 			}
 
-			operatorAddresses := reporter.Manager.GetValidatorsForNotifier(reporter.Name(), user.ID)
-			if len(operatorAddresses) == 0 {
+			status, err := reporter.GetStatus(user.ID)
+			if err != nil {
+				reporter.Logger.Error().Err(err).Msg("Error getting validators status")
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Error getting your validators status"))
+				return
+			}
+
+			if status == "" {
 				reporter.BotRespond(s, i, fmt.Sprintf(
 					"You are not subscribed to any validator's notifications on %s.",
 					reporter.Config.GetName(),
@@ -36,56 +44,95 @@ func (reporter *Reporter) GetStatusCommand() *Command {
 				return
 			}
 
-			snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
-			if !found {
-				reporter.Logger.Info().
-					Msg("No older snapshot on discord status query!")
-				reporter.BotRespond(s, i, "Could not fetch user!")
-				return
-			}
+			reporter.BotRespond(s, i, status)
+		},
+	}
+}
 
-			userEntries := snapshot.Entries.ByValidatorAddresses(operatorAddresses)
+func (reporter *Reporter) GetStatus(userID string) (string, error) {
+	operatorAddresses := reporter.Manager.GetValidatorsForNotifier(reporter.Name(), userID)
+	if len(operatorAddresses) == 0 {
+		return "", nil
+	}
 
-			entries := make([]statusEntry, len(userEntries))
+	snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+	if !found {
+		return "", errors.New("no newer snapshot")
+	}
 
-			for index, entry := range userEntries {
-				entries[index] = statusEntry{
-					IsActive:  entry.IsActive,
-					Validator: entry.Validator,
-					Link:      reporter.Config.ExplorerConfig.GetValidatorLink(entry.Validator),
-				}
+	userEntries := snapshot.Entries.ByValidatorAddresses(operatorAddresses)
 
-				if entry.IsActive && !entry.Validator.Jailed {
-					signatureInfo, err := reporter.Manager.GetValidatorMissedBlocks(entry.Validator)
-					entries[index].Error = err
-					entries[index].SigningInfo = signatureInfo
-				}
-			}
+	entries := make([]statusEntry, len(userEntries))
 
-			sort.Slice(entries, func(i, j int) bool {
-				first := entries[i]
-				second := entries[j]
+	for index, entry := range userEntries {
+		entries[index] = statusEntry{
+			IsActive:  entry.IsActive,
+			Validator: entry.Validator,
+			Link:      reporter.Config.ExplorerConfig.GetValidatorLink(entry.Validator),
+		}
 
-				if first.Validator.Jailed != second.Validator.Jailed {
-					return utils.BoolToFloat64(second.Validator.Jailed)-utils.BoolToFloat64(first.Validator.Jailed) > 0
-				}
+		if entry.IsActive && !entry.Validator.Jailed {
+			signatureInfo, err := reporter.Manager.GetValidatorMissedBlocks(entry.Validator)
+			entries[index].Error = err
+			entries[index].SigningInfo = signatureInfo
+		}
+	}
 
-				if first.IsActive != second.IsActive {
-					return utils.BoolToFloat64(second.IsActive)-utils.BoolToFloat64(first.IsActive) > 0
-				}
+	sort.Slice(entries, func(i, j int) bool {
+		first := entries[i]
+		second := entries[j]
 
-				return second.Validator.VotingPowerPercent < first.Validator.VotingPowerPercent
-			})
+		if first.Validator.Jailed != second.Validator.Jailed {
+			return utils.BoolToFloat64(second.Validator.Jailed)-utils.BoolToFloat64(first.Validator.Jailed) > 0
+		}
 
-			template, err := reporter.TemplatesManager.Render("Status", statusRender{
-				ChainConfig: reporter.Config,
-				Entries:     entries,
-			})
-			if err != nil {
-				reporter.BotRespond(s, i, "Could not render template")
-				return
-			}
-			reporter.BotRespond(s, i, template)
-		},
+		if first.IsActive != second.IsActive {
+			return utils.BoolToFloat64(second.IsActive)-utils.BoolToFloat64(first.IsActive) > 0
+		}
+
+		return second.Validator.VotingPowerPercent < first.Validator.VotingPowerPercent
+	})
+
+	return reporter.GetUserTemplatesManager(userID).Render("Status", statusRender{
+		ChainConfig: reporter.Config,
+		Entries:     entries,
+	})
+}
+
+func (b *Bot) HandleStatus(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	user := i.User
+	if user == nil {
+		user = i.Member.User
 	}
+	if user == nil {
+		b.BotRespond(s, i, "Could not fetch user!")
+		return
+	}
+
+	statuses := make([]string, 0)
+
+	for _, reporter := range b.Reporters {
+		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "status")
+
+		status, err := reporter.GetStatus(user.ID)
+		if err != nil {
+			reporter.Logger.Error().Err(err).Msg("Error getting validators status")
+			statuses = append(statuses, fmt.Sprintf(
+				"Error getting your validators status on %s",
+				reporter.Config.GetName(),
+			))
+			continue
+		}
+
+		if status != "" {
+			statuses = append(statuses, status)
+		}
+	}
+
+	if len(statuses) == 0 {
+		b.BotRespond(s, i, "You are not subscribed to any validator's notifications.")
+		return
+	}
+
+	b.BotRespond(s, i, strings.Join(statuses, "\n\n"))
 }
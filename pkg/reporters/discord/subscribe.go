@@ -1,8 +1,8 @@
 package discord
 
 import (
-	"fmt"
 	"main/pkg/constants"
+	"main/pkg/types"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -19,53 +19,164 @@ func (reporter *Reporter) GetSubscribeCommand() *Command {
 					Description: "Validator address",
 					Required:    true,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "min",
+					Description: "Minimum missed blocks percentage to be notified about, like 10",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "events",
+					Description: "Comma-separated events to be notified about, like jailed,tombstoned",
+					Required:    false,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "User to subscribe instead of yourself",
+					Required:    false,
+				},
 			},
 		},
 		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
 			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "subscribe")
 
-			options := i.ApplicationCommandData().Options
-			address, _ := options[0].Value.(string)
+			address := GetOptionValue(i, "address")
+
+			filterArgs := make([]string, 0)
+			if minMissedPercent := GetOptionValue(i, "min"); minMissedPercent != "" {
+				filterArgs = append(filterArgs, "--min", minMissedPercent)
+			}
+			if events := GetOptionValue(i, "events"); events != "" {
+				filterArgs = append(filterArgs, "--events", events)
+			}
 
 			user := i.User
 			if user == nil {
 				user = i.Member.User
 			}
 			if user == nil {
-				reporter.BotRespond(s, i, "Could not fetch user!")
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			target, allowed := reporter.GetTargetUser(s, i, user)
+			if !allowed {
 				return
 			}
 
 			validator, found := reporter.Manager.GetValidator(address)
 			if !found {
-				reporter.BotRespond(s, i, fmt.Sprintf(
-					"Could not find a validator with address `%s` on %s!",
-					address,
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
 					reporter.Config.GetName(),
 				))
 				return
 			}
 
+			filters, err := types.ParseNotifierFilters(filterArgs)
+			if err != nil {
+				reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "Could not parse filters: %s", err))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
 			added := reporter.Manager.AddNotifier(
 				address,
 				reporter.Name(),
-				user.ID,
-				user.Username,
+				target.ID,
+				target.Username,
+				filters,
 			)
 
 			if !added {
-				reporter.BotRespond(s, i, "You are already subscribed to this validator's notifications.")
+				if len(filterArgs) == 0 || !reporter.Manager.SetNotifierFilters(address, reporter.Name(), target.ID, filters) {
+					if target.ID != user.ID {
+						reporter.BotRespond(s, i, reporter.TranslateReply(
+							user.ID,
+							"%s is already subscribed to this validator's notifications",
+							target.Mention(),
+						))
+						return
+					}
+
+					reporter.BotRespond(s, i, reporter.TranslateReply(user.ID, "You are already subscribed to this validator's notifications"))
+					return
+				}
+
+				if target.ID != user.ID {
+					reporter.BotRespond(s, i, reporter.TranslateReply(
+						user.ID,
+						"Updated validator's notifications filters of %s on %s: %s, notifying about %s",
+						target.Mention(),
+						reporter.Config.GetName(),
+						validatorLinkSerialized,
+						filters.String(),
+					))
+					return
+				}
+
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Updated validator's notifications filters on %s: %s, notifying about %s",
+					reporter.Config.GetName(),
+					validatorLinkSerialized,
+					filters.String(),
+				))
 				return
 			}
 
-			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
-			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+			if target.ID != user.ID {
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Subscribed %s to validator's notifications on %s: %s, notifying about %s",
+					target.Mention(),
+					reporter.Config.GetName(),
+					validatorLinkSerialized,
+					filters.String(),
+				))
+				return
+			}
 
-			reporter.BotRespond(s, i, fmt.Sprintf(
-				"Subscribed to validator's notifications on %s: %s",
+			reporter.BotRespond(s, i, reporter.TranslateReply(
+				user.ID,
+				"Subscribed to validator's notifications on %s: %s, notifying about %s",
 				reporter.Config.GetName(),
 				validatorLinkSerialized,
+				filters.String(),
 			))
 		},
 	}
 }
+
+// GetTargetUser returns the user set in the command user option, or the sender if it is not set.
+// Only the users granted the subscribe-others permission can set it to someone else,
+// others are responded that they are not allowed to.
+func (reporter *Reporter) GetTargetUser(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	sender *discordgo.User,
+) (*discordgo.User, bool) {
+	targetID := GetOptionValue(i, "user")
+	if targetID == "" || targetID == sender.ID {
+		return sender, true
+	}
+
+	if !reporter.Bot.Permissions.IsGranted(constants.DiscordSubscribeOthersPermission, sender.ID, GetRoles(i)) {
+		reporter.Bot.DenyPermissions(s, i, constants.DiscordSubscribeOthersPermission, reporter)
+		return nil, false
+	}
+
+	if resolved := i.ApplicationCommandData().Resolved; resolved != nil {
+		if target, ok := resolved.Users[targetID]; ok {
+			return target, true
+		}
+	}
+
+	return &discordgo.User{ID: targetID, Username: targetID}, true
+}
@@ -1,7 +1,6 @@
 package discord
 
 import (
-	"fmt"
 	"main/pkg/constants"
 
 	"github.com/bwmarrin/discordgo"
@@ -19,48 +18,76 @@ func (reporter *Reporter) GetUnsubscribeCommand() *Command {
 					Description: "Validator address",
 					Required:    true,
 				},
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "User to unsubscribe instead of yourself",
+					Required:    false,
+				},
 			},
 		},
 		Handler: func(s *discordgo.Session, i *discordgo.InteractionCreate) {
 			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.DiscordReporterName, "unsubscribe")
 
-			options := i.ApplicationCommandData().Options
-			address, _ := options[0].Value.(string)
+			address := GetOptionValue(i, "address")
 
 			user := i.User
 			if user == nil {
 				user = i.Member.User
 			}
 			if user == nil {
-				reporter.BotRespond(s, i, "Could not fetch user!")
+				reporter.BotRespond(s, i, reporter.TemplatesManager.Translate("Could not fetch user!"))
+				return
+			}
+
+			target, allowed := reporter.GetTargetUser(s, i, user)
+			if !allowed {
 				return
 			}
 
 			validator, found := reporter.Manager.GetValidator(address)
 			if !found {
-				reporter.BotRespond(s, i, fmt.Sprintf(
-					"Could not find a validator with address `%s` on %s!",
-					address,
+				reporter.BotRespond(s, i, reporter.TranslateReply(
+					user.ID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
 					reporter.Config.GetName(),
 				))
 				return
 			}
 
-			removed := reporter.Manager.RemoveNotifier(address, reporter.Name(), user.ID)
-
-			if !removed {
-				reporter.BotRespond(s, i, "You are not subscribed to this validator's notifications")
-				return
-			}
-
 			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
 			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
 
-			reporter.BotRespond(s, i, fmt.Sprintf(
+			// the response is translated before the notifier is removed, as its language is lost with it
+			notSubscribed := reporter.TranslateReply(user.ID, "You are not subscribed to this validator's notifications")
+			unsubscribed := reporter.TranslateReply(
+				user.ID,
 				"Unsubscribed from validator's notifications on %s: %s",
 				reporter.Config.GetName(),
 				validatorLinkSerialized,
-			))
+			)
+			if target.ID != user.ID {
+				notSubscribed = reporter.TranslateReply(
+					user.ID,
+					"%s is not subscribed to this validator's notifications",
+					target.Mention(),
+				)
+				unsubscribed = reporter.TranslateReply(
+					user.ID,
+					"Unsubscribed %s from validator's notifications on %s: %s",
+					target.Mention(),
+					reporter.Config.GetName(),
+					validatorLinkSerialized,
+				)
+			}
+
+			if removed := reporter.Manager.RemoveNotifier(address, reporter.Name(), target.ID); !removed {
+				reporter.BotRespond(s, i, notSubscribed)
+				return
+			}
+
+			reporter.BotRespond(s, i, unsubscribed)
 		},
 	}
 }
@@ -23,7 +23,7 @@ func (reporter *Reporter) GetValidatorsCommand() *Command {
 			if !found {
 				reporter.Logger.Info().
 					Msg("No older snapshot on discord validators query!")
-				reporter.BotRespond(s, i, "Error getting validators list")
+				reporter.BotRespond(s, i, reporter.TranslateReply(GetUserID(i), "Error getting validators list"))
 				return
 			}
 
@@ -55,7 +55,7 @@ func (reporter *Reporter) GetValidatorsCommand() *Command {
 				}),
 			}
 
-			template, err := reporter.TemplatesManager.Render("Validators", render)
+			template, err := reporter.GetUserTemplatesManager(GetUserID(i)).Render("Validators", render)
 			if err != nil {
 				reporter.Logger.Error().Err(err).Msg("Error rendering missing")
 				return
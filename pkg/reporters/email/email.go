@@ -0,0 +1,283 @@
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"html/template"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/metrics"
+	statePkg "main/pkg/state"
+	templatesPkg "main/pkg/templates"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type Reporter struct {
+	EmailConfig config.EmailConfig
+
+	Logger           zerolog.Logger
+	Config           *config.ChainConfig
+	Manager          *statePkg.Manager
+	MetricsManager   *metrics.Manager
+	TemplatesManager *templatesPkg.EmailTemplateManager
+}
+
+func NewReporter(
+	chainConfig *config.ChainConfig,
+	logger zerolog.Logger,
+	manager *statePkg.Manager,
+	metricsManager *metrics.Manager,
+) *Reporter {
+	return &Reporter{
+		EmailConfig:      chainConfig.EmailConfig,
+		Config:           chainConfig,
+		Logger:           logger.With().Str("component", "email_reporter").Logger(),
+		Manager:          manager,
+		MetricsManager:   metricsManager,
+		TemplatesManager: templatesPkg.NewEmailTemplateManager(logger, chainConfig.TemplatesDir, chainConfig.Language),
+	}
+}
+
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("SMTP host not set, not creating email reporter")
+		return
+	}
+
+	if !reporter.EmailConfig.StartTLS.Bool {
+		reporter.Logger.Warn().Msg("STARTTLS is disabled, emails would be sent unencrypted")
+	}
+}
+
+func (reporter *Reporter) Enabled() bool {
+	return reporter.EmailConfig.Enabled()
+}
+
+func (reporter *Reporter) Name() constants.ReporterName {
+	return constants.EmailReporterName
+}
+
+func (reporter *Reporter) ShouldSendEvent(event types.ReportEvent) bool {
+	if !utils.Contains(reporter.EmailConfig.Events, string(event.Type())) {
+		return false
+	}
+
+	// Only escalations are worth an email, not recoveries.
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok {
+		return eventChanged.IsIncreasing()
+	}
+
+	return true
+}
+
+func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
+	validator := event.GetValidator()
+
+	eventToRender := types.RenderEventItem{
+		Event:         event,
+		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+	}
+
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok && eventChanged.IsIncreasing() {
+		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
+	}
+
+	return eventToRender
+}
+
+func (reporter *Reporter) Send(report *types.Report) error {
+	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
+
+	recipients := make([]string, 0)
+	eventsByRecipient := make(map[string][]types.ReportEvent)
+
+	for _, event := range report.Events {
+		if !reporter.ShouldSendEvent(event) {
+			continue
+		}
+
+		for _, recipient := range reporter.EmailConfig.GetRecipients(event.GetValidator().OperatorAddress) {
+			if _, ok := eventsByRecipient[recipient]; !ok {
+				recipients = append(recipients, recipient)
+			}
+
+			eventsByRecipient[recipient] = append(eventsByRecipient[recipient], event)
+		}
+	}
+
+	if len(recipients) == 0 {
+		reporter.Logger.Debug().Msg("No events to send via email")
+		return nil
+	}
+
+	var errs []error
+
+	for _, recipient := range recipients {
+		if err := report.Deliver("email:"+recipient, func() (string, error) {
+			message, err := reporter.BuildMessage(recipient, report.Height, eventsByRecipient[recipient])
+			if err != nil {
+				reporter.Logger.Error().Err(err).Str("recipient", recipient).Msg("Could not build email")
+			}
+
+			return string(message), err
+		}, func(message string) error {
+			return reporter.SendMail(recipient, []byte(message))
+		}); err != nil {
+			reporter.Logger.Error().Err(err).Str("recipient", recipient).Msg("Could not send email")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (reporter *Reporter) BuildMessage(
+	recipient string,
+	height int64,
+	reportEvents []types.ReportEvent,
+) ([]byte, error) {
+	render := reportRender{
+		Config:     reporter.Config,
+		Height:     height,
+		Time:       time.Now(),
+		Events:     make([]template.HTML, len(reportEvents)),
+		TextEvents: make([]string, len(reportEvents)),
+	}
+
+	for index, event := range reportEvents {
+		serialized := reporter.TemplatesManager.SerializeEvent(reporter.SerializeEvent(event))
+		render.Events[index] = template.HTML(serialized)
+		render.TextEvents[index] = strings.TrimSpace(utils.StripHTML(serialized))
+	}
+
+	htmlBody, err := reporter.TemplatesManager.Render("Report", render)
+	if err != nil {
+		return nil, err
+	}
+
+	textBody, err := reporter.TemplatesManager.RenderText("Report", render)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := reporter.TemplatesManager.Translate(
+		"%s: %d validator event(s) at height %d",
+		reporter.Config.GetName(),
+		len(reportEvents),
+		height,
+	)
+
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	headers := []string{
+		"From: " + reporter.EmailConfig.From,
+		"To: " + recipient,
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"Date: " + render.Time.Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + writer.Boundary(),
+	}
+
+	var message bytes.Buffer
+	for _, header := range headers {
+		message.WriteString(header + "\r\n")
+	}
+	message.WriteString("\r\n")
+
+	for _, part := range []struct {
+		ContentType string
+		Body        string
+	}{
+		{ContentType: "text/plain; charset=utf-8", Body: textBody},
+		{ContentType: "text/html; charset=utf-8", Body: htmlBody},
+	} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.ContentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err := encoder.Write([]byte(part.Body)); err != nil {
+			return nil, err
+		}
+
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	message.Write(buffer.Bytes())
+	return message.Bytes(), nil
+}
+
+func (reporter *Reporter) SendMail(recipient string, message []byte) error {
+	addr := net.JoinHostPort(reporter.EmailConfig.Host, strconv.Itoa(reporter.EmailConfig.Port))
+
+	client, err := smtp.Dial(addr)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	if reporter.EmailConfig.StartTLS.Bool {
+		if err := client.StartTLS(&tls.Config{
+			ServerName: reporter.EmailConfig.Host,
+			MinVersion: tls.VersionTLS12,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if reporter.EmailConfig.Username != "" {
+		auth := smtp.PlainAuth("", reporter.EmailConfig.Username, reporter.EmailConfig.Password, reporter.EmailConfig.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(reporter.EmailConfig.From); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(recipient); err != nil {
+		return err
+	}
+
+	dataWriter, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := dataWriter.Write(message); err != nil {
+		return err
+	}
+
+	if err := dataWriter.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,23 @@
+package email
+
+import (
+	"html/template"
+	"main/pkg/config"
+	"main/pkg/types"
+	"time"
+)
+
+type reportRender struct {
+	Config     *config.ChainConfig
+	Height     int64
+	Time       time.Time
+	Events     []template.HTML
+	TextEvents []string
+}
+
+func (r reportRender) GetAppLink() types.Link {
+	return types.Link{
+		Href: "https://github.com/QuokkaStake/missed-blocks-checker",
+		Text: "missed-blocks-checker",
+	}
+}
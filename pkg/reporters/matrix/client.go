@@ -0,0 +1,139 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	SyncTimeout = 30 * time.Second
+)
+
+type Client struct {
+	Homeserver string
+	Token      string
+
+	HTTPClient *http.Client
+	TxnCounter atomic.Int64
+}
+
+func NewClient(homeserver string, token string) *Client {
+	return &Client{
+		Homeserver: homeserver,
+		Token:      token,
+		HTTPClient: &http.Client{Timeout: SyncTimeout + 10*time.Second},
+	}
+}
+
+func (c *Client) DoRequest(method string, path string, query url.Values, body interface{}, output interface{}) error {
+	fullURL := c.Homeserver + "/_matrix/client/v3" + path
+	if len(query) > 0 {
+		fullURL += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequest(method, fullURL, bodyReader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "missed-blocks-checker")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var matrixErr ErrorResponse
+		if err := json.NewDecoder(res.Body).Decode(&matrixErr); err != nil {
+			return fmt.Errorf("got HTTP %d", res.StatusCode)
+		}
+
+		return fmt.Errorf("got HTTP %d: %s: %s", res.StatusCode, matrixErr.ErrCode, matrixErr.Error)
+	}
+
+	if output == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(output)
+}
+
+func (c *Client) Whoami() (string, error) {
+	var response WhoamiResponse
+	if err := c.DoRequest(http.MethodGet, "/account/whoami", nil, nil, &response); err != nil {
+		return "", err
+	}
+
+	return response.UserID, nil
+}
+
+func (c *Client) JoinRoom(roomIDOrAlias string) (string, error) {
+	var response JoinResponse
+	if err := c.DoRequest(
+		http.MethodPost,
+		"/join/"+url.PathEscape(roomIDOrAlias),
+		nil,
+		struct{}{},
+		&response,
+	); err != nil {
+		return "", err
+	}
+
+	return response.RoomID, nil
+}
+
+func (c *Client) SendMessage(roomID string, content MessageContent) error {
+	txnID := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + strconv.FormatInt(c.TxnCounter.Add(1), 10)
+
+	return c.DoRequest(
+		http.MethodPut,
+		"/rooms/"+url.PathEscape(roomID)+"/send/m.room.message/"+txnID,
+		nil,
+		content,
+		nil,
+	)
+}
+
+func (c *Client) Sync(since string, roomID string) (*SyncResponse, error) {
+	filter := fmt.Sprintf(
+		`{"presence":{"types":[]},"account_data":{"types":[]},"room":{"rooms":[%q],"state":{"types":[]},"ephemeral":{"types":[]},"timeline":{"types":["m.room.message"]}}}`,
+		roomID,
+	)
+
+	query := url.Values{}
+	query.Set("filter", filter)
+
+	if since != "" {
+		query.Set("since", since)
+		query.Set("timeout", strconv.FormatInt(SyncTimeout.Milliseconds(), 10))
+	}
+
+	var response SyncResponse
+	if err := c.DoRequest(http.MethodGet, "/sync", query, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,21 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+)
+
+func (reporter *Reporter) HandleHelp(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got help query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "help")
+
+	template, err := reporter.GetUserTemplatesManager(event.Sender).Render("Help", reporter.Version)
+	if err != nil {
+		return err
+	}
+
+	return reporter.BotReply(event, template)
+}
@@ -0,0 +1,59 @@
+package matrix
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strings"
+)
+
+func (reporter *Reporter) HandleLanguage(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got language query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "lang")
+
+	languages := utils.Map(constants.GetLanguages(), func(language constants.Language) string {
+		return string(language)
+	})
+
+	if len(args) < 2 || !utils.Contains(languages, args[1]) {
+		language := reporter.Manager.GetNotifierLanguage(reporter.Name(), event.Sender)
+		if language == "" {
+			language = reporter.Config.Language
+		}
+
+		return reporter.BotReply(event, fmt.Sprintf(
+			"%s\n%s",
+			html.EscapeString(reporter.TemplatesManager.WithLanguage(language).Translate(
+				"Your current language on %s is %s.",
+				reporter.Config.GetName(),
+				language,
+			)),
+			html.EscapeString(reporter.TemplatesManager.WithLanguage(language).Translate(
+				"Usage: %s <%s>",
+				args[0],
+				strings.Join(languages, "|"),
+			)),
+		))
+	}
+
+	language := constants.Language(args[1])
+	manager := reporter.TemplatesManager.WithLanguage(language)
+
+	if !reporter.Manager.SetNotifierLanguage(reporter.Name(), event.Sender, language) {
+		return reporter.BotReply(event, html.EscapeString(manager.Translate(
+			"You are not subscribed to any validator's notifications on %s.",
+			reporter.Config.GetName(),
+		)))
+	}
+
+	return reporter.BotReply(event, html.EscapeString(manager.Translate(
+		"Language on %s is set to %s.",
+		reporter.Config.GetName(),
+		language,
+	)))
+}
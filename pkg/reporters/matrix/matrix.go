@@ -0,0 +1,311 @@
+package matrix
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/i18n"
+	"main/pkg/metrics"
+	snapshotPkg "main/pkg/snapshot"
+	statePkg "main/pkg/state"
+	templatesPkg "main/pkg/templates"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	MaxMessageSize = 30000
+	CommandPrefix  = "!"
+)
+
+type Reporter struct {
+	Homeserver string
+	Token      string
+	Room       string
+	Admins     []string
+
+	Version string
+
+	Client           *Client
+	UserID           string
+	RoomID           string
+	Logger           zerolog.Logger
+	Config           *config.ChainConfig
+	Manager          *statePkg.Manager
+	SnapshotManager  *snapshotPkg.Manager
+	MetricsManager   *metrics.Manager
+	TemplatesManager templatesPkg.Manager
+	Handlers         map[string]Handler
+
+	connectMutex sync.Mutex
+}
+
+func NewReporter(
+	chainConfig *config.ChainConfig,
+	version string,
+	logger zerolog.Logger,
+	manager *statePkg.Manager,
+	metricsManager *metrics.Manager,
+	snapshotManager *snapshotPkg.Manager,
+) *Reporter {
+	return &Reporter{
+		Homeserver:       strings.TrimSuffix(chainConfig.MatrixConfig.Homeserver, "/"),
+		Token:            chainConfig.MatrixConfig.Token,
+		Room:             chainConfig.MatrixConfig.Room,
+		Admins:           chainConfig.MatrixConfig.Admins,
+		Config:           chainConfig,
+		Logger:           logger.With().Str("component", "matrix_reporter").Logger(),
+		Manager:          manager,
+		MetricsManager:   metricsManager,
+		SnapshotManager:  snapshotManager,
+		TemplatesManager: templatesPkg.NewManager(logger, constants.MatrixReporterName, chainConfig.TemplatesDir, chainConfig.Language),
+		Handlers:         make(map[string]Handler),
+		Version:          version,
+	}
+}
+
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("Matrix credentials not set, not creating Matrix reporter")
+		return
+	}
+
+	reporter.Handlers = map[string]Handler{
+		"help":        reporter.HandleHelp,
+		"subscribe":   reporter.HandleSubscribe,
+		"unsubscribe": reporter.HandleUnsubscribe,
+		"status":      reporter.HandleStatus,
+		"validators":  reporter.HandleListValidators,
+		"missing":     reporter.HandleMissingValidators,
+		"notifiers":   reporter.HandleNotifiers,
+		"params":      reporter.HandleParams,
+		"config":      reporter.HandleParams,
+		"mute":        reporter.HandleMute,
+		"unmute":      reporter.HandleUnmute,
+		"lang":        reporter.HandleLanguage,
+		"quiet":       reporter.HandleQuietHours,
+	}
+
+	queries := []string{
+		"help",
+		"missing",
+		"notifiers",
+		"params",
+		"status",
+		"subscribe",
+		"unsubscribe",
+		"validators",
+		"mute",
+		"unmute",
+		"quiet",
+		"lang",
+	}
+
+	for _, query := range queries {
+		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, query)
+	}
+
+	if err := reporter.Connect(); err != nil {
+		reporter.Logger.Warn().Err(err).Msg("Could not connect to Matrix, will retry when sending a report")
+	}
+}
+
+// Connect gets the bot user and joins the room, and starts listening for commands there.
+// It does nothing if the reporter is already connected, so it is retried on each send
+// if the homeserver was not reachable at startup.
+func (reporter *Reporter) Connect() error {
+	reporter.connectMutex.Lock()
+	defer reporter.connectMutex.Unlock()
+
+	if reporter.Client != nil {
+		return nil
+	}
+
+	client := NewClient(reporter.Homeserver, reporter.Token)
+
+	userID, err := client.Whoami()
+	if err != nil {
+		return fmt.Errorf("could not get Matrix bot user: %s", err)
+	}
+
+	roomID, err := client.JoinRoom(reporter.Room)
+	if err != nil {
+		return fmt.Errorf("could not join Matrix room %s: %s", reporter.Room, err)
+	}
+
+	reporter.Client = client
+	reporter.UserID = userID
+	reporter.RoomID = roomID
+
+	go reporter.Listen()
+	return nil
+}
+
+func (reporter *Reporter) Listen() {
+	since := ""
+
+	for {
+		response, err := reporter.Client.Sync(since, reporter.RoomID)
+		if err != nil {
+			reporter.Logger.Warn().Err(err).Msg("Could not sync with Matrix homeserver, retrying")
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		// The first sync returns the room history, we do not want to reply to old commands.
+		if since != "" {
+			if room, ok := response.Rooms.Join[reporter.RoomID]; ok {
+				for _, event := range room.Timeline.Events {
+					reporter.HandleEvent(event)
+				}
+			}
+		}
+
+		since = response.NextBatch
+	}
+}
+
+func (reporter *Reporter) HandleEvent(event RoomEvent) {
+	if event.Type != "m.room.message" || event.Sender == reporter.UserID {
+		return
+	}
+
+	args := strings.Fields(event.Content.Body)
+	if len(args) == 0 || !strings.HasPrefix(args[0], CommandPrefix) {
+		return
+	}
+
+	handler, ok := reporter.Handlers[strings.TrimPrefix(args[0], CommandPrefix)]
+	if !ok {
+		return
+	}
+
+	if len(reporter.Admins) > 0 && !utils.Contains(reporter.Admins, event.Sender) {
+		reporter.Logger.Debug().
+			Str("sender", event.Sender).
+			Str("text", event.Content.Body).
+			Msg("Got command from a user not in admins list, ignoring")
+		return
+	}
+
+	if err := handler(event, args); err != nil {
+		reporter.Logger.Error().Err(err).Str("text", event.Content.Body).Msg("Error handling Matrix command")
+	}
+}
+
+func (reporter *Reporter) Enabled() bool {
+	return reporter.Homeserver != "" && reporter.Token != "" && reporter.Room != ""
+}
+
+// GetUserLocalizer returns the localizer for the language the user has chosen,
+// or for the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserLocalizer(userID string) *i18n.Localizer {
+	if language := reporter.Manager.GetNotifierLanguage(reporter.Name(), userID); language != "" {
+		return i18n.GetLocalizer(language)
+	}
+
+	return i18n.GetLocalizer(reporter.Config.Language)
+}
+
+// TranslateReply translates the reply into the user language, escaping the message
+// and its arguments, except for the ones already serialized as HTML, like links.
+func (reporter *Reporter) TranslateReply(userID string, message string, args ...any) string {
+	return string(templatesPkg.TranslateHTML(reporter.GetUserLocalizer(userID), message, args...))
+}
+
+func SerializeCode(value string) template.HTML {
+	return template.HTML("<code>" + html.EscapeString(value) + "</code>")
+}
+
+// GetUserTemplatesManager returns the templates manager rendering replies in the language
+// the user has chosen, or in the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserTemplatesManager(userID string) templatesPkg.Manager {
+	return reporter.TemplatesManager.WithLanguage(reporter.Manager.GetNotifierLanguage(reporter.Name(), userID))
+}
+
+func (reporter *Reporter) Name() constants.ReporterName {
+	return constants.MatrixReporterName
+}
+
+func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
+	validator := event.GetValidator()
+	eventToRender := types.RenderEventItem{
+		Event:         event,
+		Notifiers:     reporter.Manager.GetNotifiersToMention(event, constants.MatrixReporterName),
+		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+	}
+
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok && eventChanged.IsIncreasing() {
+		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
+	}
+
+	if incident, found := reporter.Manager.GetIncident(validator.OperatorAddress); found {
+		eventToRender.Incident = incident
+	}
+
+	return eventToRender
+}
+
+func (reporter *Reporter) Send(report *types.Report) error {
+	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
+
+	if err := report.Deliver("room", func() (string, error) {
+		var sb strings.Builder
+
+		for _, event := range report.Events {
+			eventToRender := reporter.SerializeEvent(event)
+			sb.WriteString(reporter.TemplatesManager.SerializeEvent(eventToRender) + "\n")
+		}
+
+		return sb.String(), nil
+	}, func(reportString string) error {
+		reporter.Logger.Trace().Str("report", reportString).Msg("Sending a report")
+		return reporter.BotSend(reportString, nil)
+	}); err != nil {
+		reporter.Logger.Err(err).Msg("Could not send Matrix message")
+		return err
+	}
+
+	return nil
+}
+
+func (reporter *Reporter) BotSend(msg string, relatesTo *RelatesTo) error {
+	if err := reporter.Connect(); err != nil {
+		reporter.Logger.Error().Err(err).Msg("Could not connect to Matrix")
+		return err
+	}
+
+	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
+
+	for _, message := range messages {
+		message = strings.TrimSpace(message)
+		if message == "" {
+			continue
+		}
+
+		if err := reporter.Client.SendMessage(reporter.RoomID, MessageContent{
+			MsgType:       "m.text",
+			Body:          utils.StripHTML(message),
+			Format:        "org.matrix.custom.html",
+			FormattedBody: strings.ReplaceAll(message, "\n", "<br>"),
+			RelatesTo:     relatesTo,
+		}); err != nil {
+			reporter.Logger.Error().Err(err).Msg("Could not send Matrix message")
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (reporter *Reporter) BotReply(event RoomEvent, msg string) error {
+	return reporter.BotSend(msg, &RelatesTo{InReplyTo: &InReplyTo{EventID: event.EventID}})
+}
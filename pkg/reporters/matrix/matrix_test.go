@@ -0,0 +1,134 @@
+package matrix
+
+import (
+	"encoding/json"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	snapshotPkg "main/pkg/snapshot"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func newTestReporter(t *testing.T, homeserver string) *Reporter {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.ChainConfig{
+		Name:         "chain",
+		Language:     constants.LanguageEnglish,
+		MatrixConfig: configPkg.MatrixConfig{Homeserver: homeserver, Token: "token", Room: "!room:example.com"},
+	}
+	metricsManager := metrics.NewManager(*logger, configPkg.MetricsConfig{Enabled: null.BoolFrom(false)})
+	snapshotManager := snapshotPkg.NewManager(*logger, config, metricsManager)
+	stateManager := statePkg.NewManager(*logger, config, metricsManager, snapshotManager, nil)
+
+	return NewReporter(config, "1.0.0", *logger, stateManager, metricsManager, snapshotManager)
+}
+
+type homeserver struct {
+	*httptest.Server
+	available atomic.Bool
+	mutex     sync.Mutex
+	messages  []MessageContent
+}
+
+func newHomeserver(t *testing.T) *homeserver {
+	t.Helper()
+
+	server := &homeserver{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !server.available.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/account/whoami"):
+			_, _ = w.Write([]byte(`{"user_id":"@bot:example.com"}`))
+		case strings.Contains(r.URL.Path, "/join/"):
+			_, _ = w.Write([]byte(`{"room_id":"!room:example.com"}`))
+		case strings.Contains(r.URL.Path, "/send/m.room.message/"):
+			var content MessageContent
+			_ = json.NewDecoder(r.Body).Decode(&content)
+
+			server.mutex.Lock()
+			server.messages = append(server.messages, content)
+			server.mutex.Unlock()
+
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			// the sync loop backs off on errors, so it does not flood the test server
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (s *homeserver) GetMessages() []MessageContent {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]MessageContent{}, s.messages...)
+}
+
+func TestReporterSendAfterFailedInit(t *testing.T) {
+	t.Parallel()
+
+	server := newHomeserver(t)
+	reporter := newTestReporter(t, server.URL)
+	reporter.Init()
+
+	require.True(t, reporter.Enabled())
+	require.Nil(t, reporter.Client)
+
+	report := &types.Report{
+		Height: 100,
+		Events: []types.ReportEvent{events.ValidatorJailed{Validator: &types.Validator{Moniker: "test"}}},
+	}
+
+	require.Error(t, reporter.Send(report))
+	require.Empty(t, server.GetMessages())
+
+	server.available.Store(true)
+
+	require.NoError(t, reporter.Send(report))
+	require.NotNil(t, reporter.Client)
+	require.Equal(t, "!room:example.com", reporter.RoomID)
+	require.Len(t, server.GetMessages(), 1)
+}
+
+func TestReporterEscapesUnknownAddress(t *testing.T) {
+	t.Parallel()
+
+	server := newHomeserver(t)
+	server.available.Store(true)
+
+	reporter := newTestReporter(t, server.URL)
+	reporter.Init()
+
+	event := RoomEvent{Type: "m.room.message", EventID: "$event", Sender: "@user:example.com"}
+	require.NoError(t, reporter.HandleSubscribe(event, []string{"!subscribe", "<b>address</b>"}))
+	require.NoError(t, reporter.HandleUnsubscribe(event, []string{"!unsubscribe", "<b>address</b>"}))
+
+	messages := server.GetMessages()
+	require.Len(t, messages, 2)
+
+	for _, message := range messages {
+		require.Contains(t, message.FormattedBody, "<code>&lt;b&gt;address&lt;/b&gt;</code>")
+		require.NotContains(t, message.FormattedBody, "<b>")
+	}
+}
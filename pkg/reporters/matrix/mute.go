@@ -0,0 +1,111 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"time"
+)
+
+func (reporter *Reporter) HandleMute(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got mute query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "mute")
+
+	if len(args) < 3 {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Usage: %s <validator address> <duration>, for example: %s <validator address> 2h",
+			args[0],
+			args[0],
+		))
+	}
+
+	address := args[1]
+	duration, err := utils.ParseDuration(args[2])
+	if err != nil || duration <= 0 {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Invalid duration %s, use values like 30m, 2h or 1d",
+			SerializeCode(args[2]),
+		))
+	}
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	if !reporter.Manager.MuteNotifier(address, reporter.Name(), event.Sender, time.Now().Add(duration)) {
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "You are not subscribed to this validator's notifications"))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	return reporter.BotReply(event, reporter.TranslateReply(
+		event.Sender,
+		"Muted validator's notifications on %s for %s: %s",
+		reporter.Config.GetName(),
+		reporter.GetUserLocalizer(event.Sender).FormatDuration(duration),
+		validatorLinkSerialized,
+	))
+}
+
+func (reporter *Reporter) HandleUnmute(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got unmute query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "unmute")
+
+	if len(args) < 2 {
+		if !reporter.Manager.UnmuteNotifier("", reporter.Name(), event.Sender) {
+			return reporter.BotReply(event, reporter.TranslateReply(
+				event.Sender,
+				"You are not subscribed to any validator's notifications on %s.",
+				reporter.Config.GetName(),
+			))
+		}
+
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Unmuted all validators' notifications on %s.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	address := args[1]
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	if !reporter.Manager.UnmuteNotifier(address, reporter.Name(), event.Sender) {
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "You are not subscribed to this validator's notifications"))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	return reporter.BotReply(event, reporter.TranslateReply(
+		event.Sender,
+		"Unmuted validator's notifications on %s: %s",
+		reporter.Config.GetName(),
+		validatorLinkSerialized,
+	))
+}
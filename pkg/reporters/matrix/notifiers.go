@@ -0,0 +1,40 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+)
+
+func (reporter *Reporter) HandleNotifiers(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got notifiers query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "notifiers")
+
+	validators := reporter.Manager.GetValidators().ToSlice()
+	entries := make([]notifierEntry, 0)
+
+	for _, validator := range validators {
+		link := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+		notifiers := reporter.Manager.GetNotifiersForReporter(validator.OperatorAddress, constants.MatrixReporterName)
+		if len(notifiers) == 0 {
+			continue
+		}
+
+		entries = append(entries, notifierEntry{
+			Link:      link,
+			Notifiers: notifiers,
+		})
+	}
+
+	template, err := reporter.GetUserTemplatesManager(event.Sender).Render("Notifiers", notifierRender{
+		Entries: entries,
+		Config:  reporter.Config,
+	})
+	if err != nil {
+		return err
+	}
+
+	return reporter.BotReply(event, template)
+}
@@ -0,0 +1,40 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+)
+
+func (reporter *Reporter) HandleParams(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got params query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "params")
+
+	blockTime := reporter.Manager.GetBlockTime()
+	maxTimeToJail := reporter.Manager.GetTimeTillJail(0)
+
+	snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+	if !found {
+		reporter.Logger.Info().
+			Str("sender", event.Sender).
+			Str("text", event.Content.Body).
+			Msg("No older snapshot on matrix params query!")
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "Error getting params"))
+	}
+
+	activeValidators := snapshot.Entries.GetActive()
+	template, err := reporter.GetUserTemplatesManager(event.Sender).Render("Params", paramsRender{
+		Config:          reporter.Config,
+		BlockTime:       blockTime,
+		MaxTimeToJail:   maxTimeToJail,
+		ValidatorsCount: len(activeValidators),
+		Localizer:       reporter.GetUserLocalizer(event.Sender),
+	})
+	if err != nil {
+		return err
+	}
+
+	return reporter.BotReply(event, template)
+}
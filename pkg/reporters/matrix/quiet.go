@@ -0,0 +1,58 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+)
+
+func (reporter *Reporter) HandleQuietHours(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got quiet hours query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "quiet")
+
+	if len(args) != 2 && len(args) != 4 || len(args) == 2 && args[1] != "off" {
+		current := reporter.TranslateReply(
+			event.Sender,
+			"Your current quiet hours on %s: %s.",
+			reporter.Config.GetName(),
+			SerializeCode(reporter.Manager.GetNotifierQuietHours(reporter.Name(), event.Sender).String()),
+		)
+		usage := reporter.TranslateReply(
+			event.Sender,
+			"Usage: %s <HH:MM> <HH:MM> <timezone>, for example: %s 22:00 07:00 Europe/Berlin, or %s off",
+			args[0],
+			args[0],
+			args[0],
+		)
+
+		return reporter.BotReply(event, current+"\n"+usage)
+	}
+
+	var quietHours types.QuietHours
+	if len(args) == 4 {
+		parsed, err := types.ParseQuietHours(args[1], args[2], args[3])
+		if err != nil {
+			return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "Could not set quiet hours: %s", err))
+		}
+
+		quietHours = parsed
+	}
+
+	if !reporter.Manager.SetNotifierQuietHours(reporter.Name(), event.Sender, quietHours) {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"You are not subscribed to any validator's notifications on %s.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	return reporter.BotReply(event, reporter.TranslateReply(
+		event.Sender,
+		"Quiet hours on %s are set to %s.",
+		reporter.Config.GetName(),
+		SerializeCode(quietHours.String()),
+	))
+}
@@ -0,0 +1,77 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"sort"
+)
+
+func (reporter *Reporter) HandleStatus(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got status query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "status")
+
+	operatorAddresses := reporter.Manager.GetValidatorsForNotifier(reporter.Name(), event.Sender)
+	if len(operatorAddresses) == 0 {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"You are not subscribed to any validator's notifications on %s.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+	if !found {
+		reporter.Logger.Info().
+			Str("sender", event.Sender).
+			Str("text", event.Content.Body).
+			Msg("No older snapshot on matrix status query!")
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "Error getting your validators status"))
+	}
+
+	userEntries := snapshot.Entries.ByValidatorAddresses(operatorAddresses)
+
+	entries := make([]statusEntry, len(userEntries))
+
+	for index, entry := range userEntries {
+		entries[index] = statusEntry{
+			Validator: entry.Validator,
+			Link:      reporter.Config.ExplorerConfig.GetValidatorLink(entry.Validator),
+			IsActive:  entry.IsActive,
+		}
+
+		if entry.IsActive && !entry.Validator.Jailed {
+			signatureInfo, err := reporter.Manager.GetValidatorMissedBlocks(entry.Validator)
+			entries[index].Error = err
+			entries[index].SigningInfo = signatureInfo
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		first := entries[i]
+		second := entries[j]
+
+		if first.Validator.Jailed != second.Validator.Jailed {
+			return utils.BoolToFloat64(second.Validator.Jailed)-utils.BoolToFloat64(first.Validator.Jailed) > 0
+		}
+
+		if first.IsActive != second.IsActive {
+			return utils.BoolToFloat64(second.IsActive)-utils.BoolToFloat64(first.IsActive) > 0
+		}
+
+		return second.Validator.VotingPowerPercent < first.Validator.VotingPowerPercent
+	})
+
+	template, err := reporter.GetUserTemplatesManager(event.Sender).Render("Status", statusRender{
+		ChainConfig: reporter.Config,
+		Entries:     entries,
+	})
+	if err != nil {
+		return err
+	}
+
+	return reporter.BotReply(event, template)
+}
@@ -0,0 +1,73 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+)
+
+func (reporter *Reporter) HandleSubscribe(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got subscribe query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "subscribe")
+
+	if len(args) < 2 {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Usage: %s <validator address> [--min <missed blocks %%>] [--events <event1,event2>]",
+			args[0],
+		))
+	}
+
+	address := args[1]
+
+	filters, err := types.ParseNotifierFilters(args[2:])
+	if err != nil {
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "Could not parse filters: %s", err))
+	}
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	added := reporter.Manager.AddNotifier(
+		address,
+		reporter.Name(),
+		event.Sender,
+		event.Sender,
+		filters,
+	)
+
+	if !added {
+		if len(args) == 2 || !reporter.Manager.SetNotifierFilters(address, reporter.Name(), event.Sender, filters) {
+			return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "You are already subscribed to this validator's notifications"))
+		}
+
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Updated validator's notifications filters on %s: %s, notifying about %s",
+			reporter.Config.GetName(),
+			validatorLinkSerialized,
+			filters.String(),
+		))
+	}
+
+	return reporter.BotReply(event, reporter.TranslateReply(
+		event.Sender,
+		"Subscribed to validator's notifications on %s: %s, notifying about %s",
+		reporter.Config.GetName(),
+		validatorLinkSerialized,
+		filters.String(),
+	))
+}
@@ -0,0 +1,144 @@
+package matrix
+
+import (
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/i18n"
+	"main/pkg/types"
+	"time"
+)
+
+type ErrorResponse struct {
+	ErrCode string `json:"errcode"`
+	Error   string `json:"error"`
+}
+
+type WhoamiResponse struct {
+	UserID string `json:"user_id"`
+}
+
+type JoinResponse struct {
+	RoomID string `json:"room_id"`
+}
+
+type InReplyTo struct {
+	EventID string `json:"event_id"`
+}
+
+type RelatesTo struct {
+	InReplyTo *InReplyTo `json:"m.in_reply_to,omitempty"`
+}
+
+type MessageContent struct {
+	MsgType       string     `json:"msgtype"`
+	Body          string     `json:"body"`
+	Format        string     `json:"format,omitempty"`
+	FormattedBody string     `json:"formatted_body,omitempty"`
+	RelatesTo     *RelatesTo `json:"m.relates_to,omitempty"`
+}
+
+type RoomEvent struct {
+	Type    string         `json:"type"`
+	EventID string         `json:"event_id"`
+	Sender  string         `json:"sender"`
+	Content MessageContent `json:"content"`
+}
+
+type SyncResponse struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join map[string]struct {
+			Timeline struct {
+				Events []RoomEvent `json:"events"`
+			} `json:"timeline"`
+		} `json:"join"`
+	} `json:"rooms"`
+}
+
+type Handler func(event RoomEvent, args []string) error
+
+type missingValidatorsRender struct {
+	Config     *config.ChainConfig
+	Validators []missingValidatorsEntry
+}
+
+type missingValidatorsEntry struct {
+	Validator    *types.Validator
+	NotSigned    int64
+	Link         types.Link
+	BlocksWindow int64
+}
+
+func (e missingValidatorsEntry) FormatMissed() string {
+	return fmt.Sprintf(
+		"%.2f",
+		float64(e.NotSigned)/float64(e.BlocksWindow)*100,
+	)
+}
+
+type notifierRender struct {
+	Config  *config.ChainConfig
+	Entries []notifierEntry
+}
+
+type notifierEntry struct {
+	Link      types.Link
+	Notifiers []*types.Notifier
+}
+
+type paramsRender struct {
+	Config          *config.ChainConfig
+	BlockTime       time.Duration
+	MaxTimeToJail   time.Duration
+	ValidatorsCount int
+	Localizer       *i18n.Localizer
+}
+
+func (r paramsRender) FormatMinSignedPerWindow() string {
+	return fmt.Sprintf("%.2f", r.Config.MinSignedPerWindow*100)
+}
+
+func (r paramsRender) FormatAvgBlockTime() string {
+	return fmt.Sprintf("%.2f", r.BlockTime.Seconds())
+}
+
+func (r paramsRender) FormatTimeToJail() string {
+	return r.Localizer.FormatDuration(r.MaxTimeToJail)
+}
+
+func (r paramsRender) FormatGroupPercent(group *config.MissedBlocksGroup) string {
+	return fmt.Sprintf(
+		"%.2f%% - %.2f%%",
+		float64(group.Start)/float64(r.Config.BlocksWindow)*100,
+		float64(group.End)/float64(r.Config.BlocksWindow)*100,
+	)
+}
+
+func (r paramsRender) FormatSnapshotInterval() string {
+	if r.Config.SnapshotsInterval == 1 {
+		return r.Localizer.Translate("every block")
+	}
+
+	return r.Localizer.Translate("every %d blocks", r.Config.SnapshotsInterval)
+}
+
+type statusEntry struct {
+	IsActive    bool
+	Validator   *types.Validator
+	Error       error
+	SigningInfo types.SignatureInto
+	Link        types.Link
+}
+
+type statusRender struct {
+	Entries     []statusEntry
+	ChainConfig *config.ChainConfig
+}
+
+func (s statusRender) FormatNotSignedPercent(entry statusEntry) string {
+	return fmt.Sprintf("%.2f", float64(entry.SigningInfo.GetNotSigned())/float64(s.ChainConfig.BlocksWindow)*100)
+}
+
+func (s statusRender) FormatVotingPower(entry statusEntry) string {
+	return fmt.Sprintf("%.2f%% VP", entry.Validator.VotingPowerPercent*100)
+}
@@ -0,0 +1,46 @@
+package matrix
+
+import (
+	"main/pkg/constants"
+)
+
+func (reporter *Reporter) HandleUnsubscribe(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got unsubscribe query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "unsubscribe")
+
+	if len(args) < 2 {
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "Usage: %s <validator address>", args[0]))
+	}
+
+	address := args[1]
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(event, reporter.TranslateReply(
+			event.Sender,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	removed := reporter.Manager.RemoveNotifier(address, reporter.Name(), event.Sender)
+
+	if !removed {
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "You are not subscribed to this validator's notifications"))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	return reporter.BotReply(event, reporter.TranslateReply(
+		event.Sender,
+		"Unsubscribed from validator's notifications on %s: %s",
+		reporter.Config.GetName(),
+		validatorLinkSerialized,
+	))
+}
@@ -0,0 +1,62 @@
+package matrix
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"sort"
+)
+
+func (reporter *Reporter) HandleListValidators(event RoomEvent, args []string) error {
+	reporter.Logger.Info().
+		Str("sender", event.Sender).
+		Str("text", event.Content.Body).
+		Msg("Got list validators query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.MatrixReporterName, "validators")
+
+	snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+	if !found {
+		reporter.Logger.Info().
+			Str("sender", event.Sender).
+			Str("text", event.Content.Body).
+			Msg("No older snapshot on matrix validators query!")
+		return reporter.BotReply(event, reporter.TranslateReply(event.Sender, "Error getting validators list"))
+	}
+
+	validatorEntries := snapshot.Entries.ToSlice()
+	activeValidatorsEntries := utils.Filter(validatorEntries, func(v *types.Entry) bool {
+		return v.IsActive
+	})
+
+	sort.Slice(activeValidatorsEntries, func(firstIndex, secondIndex int) bool {
+		first := activeValidatorsEntries[firstIndex]
+		second := activeValidatorsEntries[secondIndex]
+
+		return first.SignatureInfo.GetNotSigned() < second.SignatureInfo.GetNotSigned()
+	})
+
+	render := missingValidatorsRender{
+		Config: reporter.Config,
+		Validators: utils.Map(activeValidatorsEntries, func(v *types.Entry) missingValidatorsEntry {
+			link := reporter.Config.ExplorerConfig.GetValidatorLink(v.Validator)
+			group, _, _ := reporter.Config.MissedBlocksGroups.GetGroup(v.SignatureInfo.GetNotSigned())
+			link.Text = fmt.Sprintf("%s %s", group.EmojiEnd, v.Validator.Moniker)
+
+			return missingValidatorsEntry{
+				Validator:    v.Validator,
+				Link:         link,
+				NotSigned:    v.SignatureInfo.GetNotSigned(),
+				BlocksWindow: reporter.Config.BlocksWindow,
+			}
+		}),
+	}
+
+	template, err := reporter.GetUserTemplatesManager(event.Sender).Render("Missing", render)
+	if err != nil {
+		return err
+	}
+
+	return reporter.BotReply(event, template)
+}
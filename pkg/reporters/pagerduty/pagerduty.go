@@ -0,0 +1,228 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	httpPkg "main/pkg/http"
+	"main/pkg/metrics"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"main/pkg/utils"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	EventActionTrigger = "trigger"
+	EventActionResolve = "resolve"
+)
+
+type Reporter struct {
+	RoutingKey       string
+	APIURL           string
+	TriggerThreshold float64
+
+	Client         *httpPkg.Client
+	Logger         zerolog.Logger
+	Config         *config.ChainConfig
+	Manager        *statePkg.Manager
+	MetricsManager *metrics.Manager
+}
+
+func NewReporter(
+	chainConfig *config.ChainConfig,
+	logger zerolog.Logger,
+	manager *statePkg.Manager,
+	metricsManager *metrics.Manager,
+) *Reporter {
+	return &Reporter{
+		RoutingKey:       chainConfig.PagerDutyConfig.RoutingKey,
+		APIURL:           chainConfig.PagerDutyConfig.APIURL,
+		TriggerThreshold: chainConfig.PagerDutyConfig.TriggerThreshold,
+		Config:           chainConfig,
+		Logger:           logger.With().Str("component", "pagerduty_reporter").Logger(),
+		Manager:          manager,
+		MetricsManager:   metricsManager,
+	}
+}
+
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("PagerDuty routing key not set, not creating PagerDuty reporter")
+		return
+	}
+
+	reporter.Client = httpPkg.NewClient(
+		reporter.Logger,
+		reporter.MetricsManager,
+		reporter.APIURL,
+		reporter.Config.Name,
+	)
+}
+
+func (reporter *Reporter) Enabled() bool {
+	return reporter.RoutingKey != ""
+}
+
+func (reporter *Reporter) Name() constants.ReporterName {
+	return constants.PagerDutyReporterName
+}
+
+func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
+	validator := event.GetValidator()
+
+	eventToRender := types.RenderEventItem{
+		Event:         event,
+		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+	}
+
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok && eventChanged.IsIncreasing() {
+		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
+	}
+
+	return eventToRender
+}
+
+func (reporter *Reporter) GetEventAction(event types.ReportEvent) (string, bool) {
+	switch entry := event.(type) {
+	case events.ValidatorJailed, events.ValidatorTombstoned:
+		return EventActionTrigger, true
+	case events.ValidatorUnjailed:
+		return EventActionResolve, true
+	case events.ValidatorGroupChanged:
+		if !entry.IsIncreasing() {
+			if entry.MissedBlocksGroupAfter.Start == 0 {
+				return EventActionResolve, true
+			}
+
+			return "", false
+		}
+
+		triggerStart := int64((float64(reporter.Config.BlocksWindow) + 1) * reporter.TriggerThreshold / 100)
+		return EventActionTrigger, entry.MissedBlocksGroupAfter.Start >= triggerStart
+	default:
+		return "", false
+	}
+}
+
+func (reporter *Reporter) GetDedupKey(validator *types.Validator) string {
+	return fmt.Sprintf("missed-blocks-checker-%s-%s", reporter.Config.Name, validator.OperatorAddress)
+}
+
+func (reporter *Reporter) GetSummary(event types.RenderEventItem) string {
+	validator := event.Event.GetValidator()
+
+	switch entry := event.Event.(type) {
+	case events.ValidatorJailed:
+		return fmt.Sprintf("%s has been jailed on %s", validator.Moniker, reporter.Config.GetName())
+	case events.ValidatorTombstoned:
+		return fmt.Sprintf("%s has been tombstoned on %s", validator.Moniker, reporter.Config.GetName())
+	case events.ValidatorGroupChanged:
+		summary := fmt.Sprintf(
+			"%s %s on %s: %d missed blocks",
+			validator.Moniker,
+			entry.GetDescription(),
+			reporter.Config.GetName(),
+			entry.MissedBlocksAfter,
+		)
+
+		if entry.IsIncreasing() {
+			summary += fmt.Sprintf(" (%s till jail)", utils.FormatDuration(event.TimeToJail))
+		}
+
+		return summary
+	default:
+		return fmt.Sprintf("%s: %s on %s", event.Event.Type(), validator.Moniker, reporter.Config.GetName())
+	}
+}
+
+func (reporter *Reporter) SerializeEventPayload(
+	height int64,
+	event types.RenderEventItem,
+	action string,
+) Event {
+	validator := event.Event.GetValidator()
+
+	pagerDutyEvent := Event{
+		RoutingKey:  reporter.RoutingKey,
+		EventAction: action,
+		DedupKey:    reporter.GetDedupKey(validator),
+	}
+
+	if action == EventActionResolve {
+		return pagerDutyEvent
+	}
+
+	customDetails := map[string]interface{}{
+		"height":            height,
+		"moniker":           validator.Moniker,
+		"operator_address":  validator.OperatorAddress,
+		"consensus_address": validator.ConsensusAddressValcons,
+	}
+
+	if eventChanged, ok := event.Event.(events.ValidatorGroupChanged); ok {
+		customDetails["missed_blocks_before"] = eventChanged.MissedBlocksBefore
+		customDetails["missed_blocks_after"] = eventChanged.MissedBlocksAfter
+		customDetails["time_to_jail_seconds"] = event.TimeToJail.Seconds()
+	}
+
+	pagerDutyEvent.Payload = &Payload{
+		Summary:       reporter.GetSummary(event),
+		Source:        reporter.Config.Name,
+		Severity:      reporter.Config.PagerDutyConfig.GetSeverity(event.Event.Type()),
+		Component:     validator.OperatorAddress,
+		Group:         reporter.Config.Name,
+		Class:         string(event.Event.Type()),
+		CustomDetails: customDetails,
+	}
+
+	if event.ValidatorLink.Href != "" {
+		pagerDutyEvent.Links = []Link{{Href: event.ValidatorLink.Href, Text: event.ValidatorLink.Text}}
+	}
+
+	return pagerDutyEvent
+}
+
+func (reporter *Reporter) Send(report *types.Report) error {
+	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
+
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+
+	var errs []error
+
+	for index, event := range report.Events {
+		action, ok := reporter.GetEventAction(event)
+		if !ok {
+			continue
+		}
+
+		if err := report.Deliver(fmt.Sprintf("event:%d", index), func() (string, error) {
+			payload := reporter.SerializeEventPayload(report.Height, reporter.SerializeEvent(event), action)
+
+			body, err := json.Marshal(payload)
+			if err != nil {
+				reporter.Logger.Error().Err(err).Msg("Could not marshal PagerDuty event")
+				return "", err
+			}
+
+			return string(body), nil
+		}, func(body string) error {
+			return reporter.Client.Post("/v2/enqueue", constants.QueryTypePagerDuty, []byte(body), headers)
+		}); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("action", action).
+				Str("validator", event.GetValidator().OperatorAddress).
+				Msg("Could not send PagerDuty event")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,66 @@
+package pagerduty
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/events"
+	"main/pkg/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func groupChanged(before *configPkg.MissedBlocksGroup, after *configPkg.MissedBlocksGroup) events.ValidatorGroupChanged {
+	return events.ValidatorGroupChanged{
+		Validator:               &types.Validator{},
+		MissedBlocksGroupBefore: before,
+		MissedBlocksGroupAfter:  after,
+	}
+}
+
+func TestGetEventAction(t *testing.T) {
+	t.Parallel()
+
+	recovered := &configPkg.MissedBlocksGroup{Start: 0, End: 99}
+	low := &configPkg.MissedBlocksGroup{Start: 100, End: 499}
+	high := &configPkg.MissedBlocksGroup{Start: 500, End: 999}
+	highest := &configPkg.MissedBlocksGroup{Start: 1000, End: 10000}
+
+	// with a window of 10000 blocks and a 5% threshold, incidents are triggered from 500 missed blocks
+	reporter := &Reporter{
+		TriggerThreshold: 5,
+		Config:           &configPkg.ChainConfig{BlocksWindow: 10000},
+	}
+
+	testCases := []struct {
+		name   string
+		event  types.ReportEvent
+		action string
+		ok     bool
+	}{
+		{name: "jailed", event: events.ValidatorJailed{Validator: &types.Validator{}}, action: EventActionTrigger, ok: true},
+		{name: "tombstoned", event: events.ValidatorTombstoned{Validator: &types.Validator{}}, action: EventActionTrigger, ok: true},
+		{name: "unjailed", event: events.ValidatorUnjailed{Validator: &types.Validator{}}, action: EventActionResolve, ok: true},
+		{name: "increasing below threshold", event: groupChanged(recovered, low), action: EventActionTrigger, ok: false},
+		{name: "increasing to threshold", event: groupChanged(low, high), action: EventActionTrigger, ok: true},
+		{name: "increasing above threshold", event: groupChanged(high, highest), action: EventActionTrigger, ok: true},
+		{name: "decreasing above threshold", event: groupChanged(highest, high), action: "", ok: false},
+		{name: "decreasing below threshold", event: groupChanged(high, low), action: "", ok: false},
+		{name: "recovering", event: groupChanged(low, recovered), action: EventActionResolve, ok: true},
+		{name: "recovering from threshold", event: groupChanged(highest, recovered), action: EventActionResolve, ok: true},
+		{name: "active", event: events.ValidatorActive{Validator: &types.Validator{}}, action: "", ok: false},
+		{name: "inactive", event: events.ValidatorInactive{Validator: &types.Validator{}}, action: "", ok: false},
+		{name: "reminder", event: events.ValidatorIncidentReminder{Validator: &types.Validator{}}, action: "", ok: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, ok := reporter.GetEventAction(testCase.event)
+			require.Equal(t, testCase.ok, ok)
+			if ok {
+				require.Equal(t, testCase.action, action)
+			}
+		})
+	}
+}
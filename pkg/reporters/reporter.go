@@ -2,6 +2,7 @@ package reporters
 
 import (
 	"main/pkg/constants"
+	snapshotPkg "main/pkg/snapshot"
 	"main/pkg/types"
 )
 
@@ -12,3 +13,9 @@ type Reporter interface {
 	SerializeEvent(event types.ReportEvent) types.RenderEventItem
 	Send(report *types.Report) error
 }
+
+// SnapshotReporter is implemented by the reporters that need the validators state
+// on each snapshot, even if it did not produce any events.
+type SnapshotReporter interface {
+	ReportSnapshot(height int64, snapshot snapshotPkg.Snapshot) error
+}
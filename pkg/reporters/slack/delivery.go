@@ -0,0 +1,55 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"net/http"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetDeliveryCommand() *Command {
+	return &Command{
+		Name: "delivery",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "delivery")
+
+			deliveryModes := utils.Map(constants.GetDeliveryModes(), func(mode constants.DeliveryMode) string {
+				return string(mode)
+			})
+
+			args := strings.Fields(c.Text)
+			if len(args) < 1 || !utils.Contains(deliveryModes, args[0]) {
+				current := reporter.TranslateReply(
+					c.UserID,
+					"Your current delivery mode on %s is %s.",
+					reporter.Config.GetName(),
+					SerializeCode(string(reporter.Manager.GetNotifierDeliveryMode(reporter.Name(), c.UserID))),
+				)
+				usage := reporter.TranslateReply(c.UserID, "Usage: %s <%s>", c.Command, strings.Join(deliveryModes, "|"))
+
+				reporter.BotRespond(w, c, current+"\n"+usage)
+				return
+			}
+
+			deliveryMode := constants.DeliveryMode(args[0])
+
+			if !reporter.Manager.SetNotifierDeliveryMode(reporter.Name(), c.UserID, deliveryMode) {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			reporter.BotRespond(w, c, reporter.TranslateReply(
+				c.UserID,
+				"Delivery mode on %s is set to %s.",
+				reporter.Config.GetName(),
+				SerializeCode(string(deliveryMode)),
+			))
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"net/http"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetHelpCommand() *Command {
+	return &Command{
+		Name: "help",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "help")
+
+			template, err := reporter.GetUserTemplatesManager(c.UserID).Render("Help", helpRender{
+				Version:  reporter.Version,
+				Commands: reporter.Commands,
+			})
+			if err != nil {
+				reporter.Logger.Error().Err(err).Str("template", "help").Msg("Error rendering template")
+				return
+			}
+
+			reporter.BotRespond(w, c, template)
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"net/http"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetLanguageCommand() *Command {
+	return &Command{
+		Name: "lang",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "lang")
+
+			languages := utils.Map(constants.GetLanguages(), func(language constants.Language) string {
+				return string(language)
+			})
+
+			args := strings.Fields(c.Text)
+			if len(args) < 1 || !utils.Contains(languages, args[0]) {
+				language := reporter.Manager.GetNotifierLanguage(reporter.Name(), c.UserID)
+				if language == "" {
+					language = reporter.Config.Language
+				}
+
+				manager := reporter.TemplatesManager.WithLanguage(language)
+				reporter.BotRespond(w, c, fmt.Sprintf(
+					"%s\n%s",
+					manager.Translate("Your current language on %s is %s.", reporter.Config.GetName(), language),
+					manager.Translate("Usage: %s <%s>", c.Command, strings.Join(languages, "|")),
+				))
+				return
+			}
+
+			language := constants.Language(args[0])
+			manager := reporter.TemplatesManager.WithLanguage(language)
+
+			if !reporter.Manager.SetNotifierLanguage(reporter.Name(), c.UserID, language) {
+				reporter.BotRespond(w, c, manager.Translate(
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			reporter.BotRespond(w, c, manager.Translate(
+				"Language on %s is set to %s.",
+				reporter.Config.GetName(),
+				language,
+			))
+		},
+	}
+}
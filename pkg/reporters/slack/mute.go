@@ -0,0 +1,125 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetMuteCommand() *Command {
+	return &Command{
+		Name: "mute",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "mute")
+
+			args := strings.Fields(c.Text)
+			if len(args) < 2 {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Usage: %s <validator address> <duration>, for example: %s <validator address> 2h",
+					c.Command,
+					c.Command,
+				))
+				return
+			}
+
+			address := args[0]
+			duration, err := utils.ParseDuration(args[1])
+			if err != nil || duration <= 0 {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Invalid duration %s, use values like 30m, 2h or 1d",
+					SerializeCode(args[1]),
+				))
+				return
+			}
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			if !reporter.Manager.MuteNotifier(address, reporter.Name(), c.UserID, time.Now().Add(duration)) {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "You are not subscribed to this validator's notifications"))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			reporter.BotRespond(w, c, reporter.TranslateReply(
+				c.UserID,
+				"Muted validator's notifications on %s for %s: %s",
+				reporter.Config.GetName(),
+				reporter.GetUserLocalizer(c.UserID).FormatDuration(duration),
+				validatorLinkSerialized,
+			))
+		},
+	}
+}
+
+func (reporter *Reporter) GetUnmuteCommand() *Command {
+	return &Command{
+		Name: "unmute",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "unmute")
+
+			args := strings.Fields(c.Text)
+			if len(args) < 1 {
+				if !reporter.Manager.UnmuteNotifier("", reporter.Name(), c.UserID) {
+					reporter.BotRespond(w, c, reporter.TranslateReply(
+						c.UserID,
+						"You are not subscribed to any validator's notifications on %s.",
+						reporter.Config.GetName(),
+					))
+					return
+				}
+
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Unmuted all validators' notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			address := args[0]
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			if !reporter.Manager.UnmuteNotifier(address, reporter.Name(), c.UserID) {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "You are not subscribed to this validator's notifications"))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			reporter.BotRespond(w, c, reporter.TranslateReply(
+				c.UserID,
+				"Unmuted validator's notifications on %s: %s",
+				reporter.Config.GetName(),
+				validatorLinkSerialized,
+			))
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"net/http"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetNotifiersCommand() *Command {
+	return &Command{
+		Name: "notifiers",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "notifiers")
+
+			validators := reporter.Manager.GetValidators().ToSlice()
+			entries := make([]notifierEntry, 0)
+
+			for _, validator := range validators {
+				link := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+				notifiers := reporter.Manager.GetNotifiersForReporter(validator.OperatorAddress, constants.SlackReporterName)
+				if len(notifiers) == 0 {
+					continue
+				}
+
+				entries = append(entries, notifierEntry{
+					Link:      link,
+					Notifiers: notifiers,
+				})
+			}
+
+			template, err := reporter.GetUserTemplatesManager(c.UserID).Render("Notifiers", notifierRender{
+				Entries: entries,
+				Config:  reporter.Config,
+			})
+			if err != nil {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Error rendering notifiers template"))
+				return
+			}
+
+			reporter.BotRespond(w, c, template)
+		},
+	}
+}
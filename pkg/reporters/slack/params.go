@@ -0,0 +1,42 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"net/http"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetParamsCommand() *Command {
+	return &Command{
+		Name: "params",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "params")
+
+			blockTime := reporter.Manager.GetBlockTime()
+			maxTimeToJail := reporter.Manager.GetTimeTillJail(0)
+
+			snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+			if !found {
+				reporter.Logger.Info().Msg("No older snapshot on slack params query!")
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Error getting params"))
+				return
+			}
+
+			activeValidators := snapshot.Entries.GetActive()
+			template, err := reporter.GetUserTemplatesManager(c.UserID).Render("Params", paramsRender{
+				Config:          reporter.Config,
+				BlockTime:       blockTime,
+				MaxTimeToJail:   maxTimeToJail,
+				ValidatorsCount: len(activeValidators),
+				Localizer:       reporter.GetUserLocalizer(c.UserID),
+			})
+			if err != nil {
+				reporter.Logger.Error().Err(err).Msg("Error rendering params template")
+				return
+			}
+
+			reporter.BotRespond(w, c, template)
+		},
+	}
+}
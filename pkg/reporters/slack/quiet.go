@@ -0,0 +1,66 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"net/http"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetQuietHoursCommand() *Command {
+	return &Command{
+		Name: "quiet",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "quiet")
+
+			args := strings.Fields(c.Text)
+			if len(args) != 1 && len(args) != 3 || len(args) == 1 && args[0] != "off" {
+				current := reporter.TranslateReply(
+					c.UserID,
+					"Your current quiet hours on %s: %s.",
+					reporter.Config.GetName(),
+					SerializeCode(reporter.Manager.GetNotifierQuietHours(reporter.Name(), c.UserID).String()),
+				)
+				usage := reporter.TranslateReply(
+					c.UserID,
+					"Usage: %s <HH:MM> <HH:MM> <timezone>, for example: %s 22:00 07:00 Europe/Berlin, or %s off",
+					c.Command,
+					c.Command,
+					c.Command,
+				)
+
+				reporter.BotRespond(w, c, current+"\n"+usage)
+				return
+			}
+
+			var quietHours types.QuietHours
+			if len(args) == 3 {
+				parsed, err := types.ParseQuietHours(args[0], args[1], args[2])
+				if err != nil {
+					reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Could not set quiet hours: %s", err))
+					return
+				}
+
+				quietHours = parsed
+			}
+
+			if !reporter.Manager.SetNotifierQuietHours(reporter.Name(), c.UserID, quietHours) {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			reporter.BotRespond(w, c, reporter.TranslateReply(
+				c.UserID,
+				"Quiet hours on %s are set to %s.",
+				reporter.Config.GetName(),
+				SerializeCode(quietHours.String()),
+			))
+		},
+	}
+}
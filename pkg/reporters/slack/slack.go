@@ -0,0 +1,377 @@
+package slack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/i18n"
+	"main/pkg/metrics"
+	snapshotPkg "main/pkg/snapshot"
+	statePkg "main/pkg/state"
+	templatesPkg "main/pkg/templates"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// Slack does not allow section blocks with text longer than 3000 chars
+	// and messages with more than 50 blocks.
+	MaxBlockTextSize   = 3000
+	MaxBlocksInMessage = 50
+)
+
+type Reporter struct {
+	Token         string
+	Channel       string
+	WebhookURL    string
+	SigningSecret string
+	ListenAddr    string
+	Path          string
+
+	Version string
+
+	SlackClient      *slack.Client
+	Logger           zerolog.Logger
+	Config           *config.ChainConfig
+	Manager          *statePkg.Manager
+	MetricsManager   *metrics.Manager
+	SnapshotManager  *snapshotPkg.Manager
+	TemplatesManager templatesPkg.Manager
+	Commands         map[string]*Command
+}
+
+func NewReporter(
+	chainConfig *config.ChainConfig,
+	version string,
+	logger zerolog.Logger,
+	manager *statePkg.Manager,
+	metricsManager *metrics.Manager,
+	snapshotManager *snapshotPkg.Manager,
+) *Reporter {
+	return &Reporter{
+		Token:            chainConfig.SlackConfig.Token,
+		Channel:          chainConfig.SlackConfig.Channel,
+		WebhookURL:       chainConfig.SlackConfig.WebhookURL,
+		SigningSecret:    chainConfig.SlackConfig.SigningSecret,
+		ListenAddr:       chainConfig.SlackConfig.ListenAddr,
+		Path:             chainConfig.SlackConfig.Path,
+		Config:           chainConfig,
+		Logger:           logger.With().Str("component", "slack_reporter").Logger(),
+		Manager:          manager,
+		MetricsManager:   metricsManager,
+		SnapshotManager:  snapshotManager,
+		TemplatesManager: templatesPkg.NewManager(logger, constants.SlackReporterName, chainConfig.TemplatesDir, chainConfig.Language),
+		Commands:         make(map[string]*Command, 0),
+		Version:          version,
+	}
+}
+
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("Slack credentials not set, not creating Slack reporter")
+		return
+	}
+
+	if reporter.Token != "" {
+		reporter.SlackClient = slack.New(reporter.Token)
+	}
+
+	reporter.Commands = map[string]*Command{
+		"params":      reporter.GetParamsCommand(),
+		"missing":     reporter.GetMissingCommand(),
+		"validators":  reporter.GetValidatorsCommand(),
+		"subscribe":   reporter.GetSubscribeCommand(),
+		"unsubscribe": reporter.GetUnsubscribeCommand(),
+		"status":      reporter.GetStatusCommand(),
+		"help":        reporter.GetHelpCommand(),
+		"notifiers":   reporter.GetNotifiersCommand(),
+		"delivery":    reporter.GetDeliveryCommand(),
+		"lang":        reporter.GetLanguageCommand(),
+		"mute":        reporter.GetMuteCommand(),
+		"unmute":      reporter.GetUnmuteCommand(),
+		"quiet":       reporter.GetQuietHoursCommand(),
+	}
+
+	if reporter.SigningSecret == "" || reporter.ListenAddr == "" {
+		reporter.Logger.Info().Msg("Slack signing secret or listen address not set, not listening for slash commands")
+		return
+	}
+
+	for query := range reporter.Commands {
+		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, query)
+	}
+
+	go reporter.StartServer()
+}
+
+func (reporter *Reporter) StartServer() {
+	handler := http.NewServeMux()
+	handler.HandleFunc(reporter.Path, reporter.HandleSlashCommand)
+
+	server := &http.Server{
+		Addr:              reporter.ListenAddr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	reporter.Logger.Info().
+		Str("addr", reporter.ListenAddr).
+		Str("path", reporter.Path).
+		Msg("Slack slash commands handler listening")
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		reporter.Logger.Error().
+			Err(err).
+			Str("addr", reporter.ListenAddr).
+			Msg("Cannot start Slack slash commands handler")
+	}
+}
+
+func (reporter *Reporter) HandleSlashCommand(w http.ResponseWriter, r *http.Request) {
+	verifier, err := slack.NewSecretsVerifier(r.Header, reporter.SigningSecret)
+	if err != nil {
+		reporter.Logger.Warn().Err(err).Msg("Could not create Slack request verifier")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.Body = io.NopCloser(io.TeeReader(r.Body, &verifier))
+
+	command, err := slack.SlashCommandParse(r)
+	if err != nil {
+		reporter.Logger.Warn().Err(err).Msg("Could not parse Slack slash command")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := verifier.Ensure(); err != nil {
+		reporter.Logger.Warn().Err(err).Msg("Got Slack slash command with invalid signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	reporter.Logger.Info().
+		Str("sender", command.UserName).
+		Str("command", command.Command).
+		Str("text", command.Text).
+		Msg("Got Slack slash command")
+
+	if cmd, ok := reporter.Commands[strings.TrimPrefix(command.Command, "/")]; ok {
+		cmd.Handler(w, command)
+		return
+	}
+
+	reporter.BotRespond(w, command, reporter.TranslateReply(command.UserID, "Unknown command."))
+}
+
+func (reporter *Reporter) Enabled() bool {
+	return (reporter.Token != "" && reporter.Channel != "") || reporter.WebhookURL != ""
+}
+
+// GetUserLocalizer returns the localizer for the language the user has chosen,
+// or for the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserLocalizer(userID string) *i18n.Localizer {
+	if language := reporter.Manager.GetNotifierLanguage(reporter.Name(), userID); language != "" {
+		return i18n.GetLocalizer(language)
+	}
+
+	return i18n.GetLocalizer(reporter.Config.Language)
+}
+
+// TranslateReply translates the reply into the user language.
+func (reporter *Reporter) TranslateReply(userID string, message string, args ...any) string {
+	return reporter.GetUserLocalizer(userID).Translate(message, args...)
+}
+
+func SerializeCode(value string) string {
+	return "`" + value + "`"
+}
+
+// GetUserTemplatesManager returns the templates manager rendering replies in the language
+// the user has chosen, or in the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserTemplatesManager(userID string) templatesPkg.Manager {
+	return reporter.TemplatesManager.WithLanguage(reporter.Manager.GetNotifierLanguage(reporter.Name(), userID))
+}
+
+func (reporter *Reporter) Name() constants.ReporterName {
+	return constants.SlackReporterName
+}
+
+func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
+	validator := event.GetValidator()
+	eventToRender := types.RenderEventItem{
+		Event:         event,
+		Notifiers:     reporter.Manager.GetNotifiersToMention(event, constants.SlackReporterName),
+		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+	}
+
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok && eventChanged.IsIncreasing() {
+		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
+	}
+
+	if incident, found := reporter.Manager.GetIncident(validator.OperatorAddress); found {
+		eventToRender.Incident = incident
+	}
+
+	return eventToRender
+}
+
+func (reporter *Reporter) Send(report *types.Report) error {
+	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
+
+	renderers := utils.Map(report.Events, func(event types.ReportEvent) func() string {
+		return func() string {
+			return reporter.TemplatesManager.SerializeEvent(reporter.SerializeEvent(event))
+		}
+	})
+
+	reporter.Logger.Trace().Int("blocks", len(renderers)).Msg("Sending a report")
+
+	if err := reporter.DeliverBlocks(report, "channel", reporter.Channel, renderers); err != nil {
+		reporter.Logger.Err(err).Msg("Could not send Slack message")
+		return err
+	}
+
+	// Direct messages can only be sent via the bot token, not via the webhook.
+	if reporter.SlackClient == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, message := range reporter.Manager.GetDirectMessages(report, reporter.Name()) {
+		if err := reporter.SendDirectMessage(report, message); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("user", message.UserName).
+				Msg("Could not send Slack direct message")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (reporter *Reporter) SendDirectMessage(report *types.Report, message *types.DirectMessage) error {
+	manager := reporter.TemplatesManager.WithLanguage(message.Language)
+
+	renderers := []func() string{
+		func() string {
+			return manager.Translate("Updates on %s for validators you are subscribed to:", reporter.Config.GetName())
+		},
+	}
+
+	for _, event := range message.Events {
+		renderers = append(renderers, func() string {
+			eventToRender := reporter.SerializeEvent(event)
+			eventToRender.Notifiers = nil
+			return manager.SerializeEvent(eventToRender)
+		})
+	}
+
+	return reporter.DeliverBlocks(report, "dm:"+message.UserID, message.UserID, renderers)
+}
+
+// DeliverBlocks sends the blocks in messages of up to MaxBlocksInMessage blocks, each of them
+// being a separate delivery of the report, so a retry only sends the messages that failed.
+func (reporter *Reporter) DeliverBlocks(
+	report *types.Report,
+	destination string,
+	channel string,
+	renderers []func() string,
+) error {
+	for index, chunk := range utils.SplitIntoChunks(renderers, MaxBlocksInMessage) {
+		if err := report.Deliver(fmt.Sprintf("%s:%d", destination, index), func() (string, error) {
+			content, err := json.Marshal(utils.Map(chunk, func(render func() string) string {
+				return render()
+			}))
+			return string(content), err
+		}, func(content string) error {
+			var texts []string
+			if err := json.Unmarshal([]byte(content), &texts); err != nil {
+				return err
+			}
+
+			return reporter.BotSend(channel, utils.Map(texts, func(text string) slack.Block {
+				return NewMarkdownBlock(text)
+			}))
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (reporter *Reporter) BotSend(channel string, blocks []slack.Block) error {
+	if reporter.SlackClient != nil && channel != "" {
+		_, _, err := reporter.SlackClient.PostMessage(
+			channel,
+			slack.MsgOptionBlocks(blocks...),
+			slack.MsgOptionDisableLinkUnfurl(),
+		)
+		return err
+	}
+
+	return slack.PostWebhook(reporter.WebhookURL, &slack.WebhookMessage{
+		Blocks: &slack.Blocks{BlockSet: blocks},
+	})
+}
+
+func (reporter *Reporter) BotRespond(w http.ResponseWriter, command slack.SlashCommand, text string) {
+	blocks := utils.Map(
+		utils.SplitStringIntoChunks(text, MaxBlockTextSize),
+		func(chunk string) slack.Block { return NewMarkdownBlock(chunk) },
+	)
+	messages := utils.SplitIntoChunks(blocks, MaxBlocksInMessage)
+	firstMessage, rest := messages[0], messages[1:]
+
+	response, err := json.Marshal(&slack.WebhookMessage{
+		ResponseType: slack.ResponseTypeInChannel,
+		Blocks:       &slack.Blocks{BlockSet: firstMessage},
+	})
+	if err != nil {
+		reporter.Logger.Error().Err(err).Msg("Error marshalling response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(response); err != nil {
+		reporter.Logger.Error().Err(err).Msg("Error sending response")
+	}
+
+	for index, message := range rest {
+		if err := slack.PostWebhook(command.ResponseURL, &slack.WebhookMessage{
+			ResponseType: slack.ResponseTypeInChannel,
+			Blocks:       &slack.Blocks{BlockSet: message},
+		}); err != nil {
+			reporter.Logger.Error().
+				Int("chunk", index).
+				Err(err).
+				Msg("Error sending followup message")
+		}
+	}
+}
+
+func (reporter *Reporter) SerializeDate(date time.Time) string {
+	return reporter.TemplatesManager.SerializeDate(date)
+}
+
+func NewMarkdownBlock(text string) slack.Block {
+	return slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, text, false, false),
+		nil,
+		nil,
+	)
+}
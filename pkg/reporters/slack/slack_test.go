@@ -0,0 +1,207 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	snapshotPkg "main/pkg/snapshot"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+type webhookServer struct {
+	*httptest.Server
+	mutex  sync.Mutex
+	bodies []string
+}
+
+func newWebhookServer(t *testing.T) *webhookServer {
+	t.Helper()
+
+	server := &webhookServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		server.mutex.Lock()
+		server.bodies = append(server.bodies, string(body))
+		server.mutex.Unlock()
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestReporter(t *testing.T, webhookURL string, templatesDir string) *Reporter {
+	t.Helper()
+
+	return newTestReporterWithLanguage(t, webhookURL, templatesDir, constants.LanguageEnglish)
+}
+
+func newTestReporterWithLanguage(
+	t *testing.T,
+	webhookURL string,
+	templatesDir string,
+	language constants.Language,
+) *Reporter {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	config := &configPkg.ChainConfig{
+		Name:           "chain",
+		BlocksWindow:   10000,
+		TemplatesDir:   templatesDir,
+		Language:       language,
+		SlackConfig:    configPkg.SlackConfig{WebhookURL: webhookURL},
+		ExplorerConfig: configPkg.ExplorerConfig{MintscanPrefix: "cosmos"},
+	}
+	metricsManager := metrics.NewManager(*logger, configPkg.MetricsConfig{Enabled: null.BoolFrom(false)})
+	snapshotManager := snapshotPkg.NewManager(*logger, config, metricsManager)
+	stateManager := statePkg.NewManager(*logger, config, metricsManager, snapshotManager, nil)
+
+	reporter := NewReporter(config, "1.0.0", *logger, stateManager, metricsManager, snapshotManager)
+	reporter.Init()
+
+	return reporter
+}
+
+func TestSendBlockKit(t *testing.T) {
+	t.Parallel()
+
+	server := newWebhookServer(t)
+	reporter := newTestReporter(t, server.URL, "")
+
+	validator := &types.Validator{Moniker: "test", OperatorAddress: "cosmosvaloper1xxx"}
+	err := reporter.Send(&types.Report{
+		Height: 100,
+		Events: []types.ReportEvent{
+			events.ValidatorJailed{Validator: validator},
+			events.ValidatorGroupChanged{
+				Validator:               validator,
+				MissedBlocksBefore:      150,
+				MissedBlocksAfter:       50,
+				MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{Start: 100, End: 499, EmojiEnd: "🟡", DescEnd: "is recovering"},
+				MissedBlocksGroupAfter:  &configPkg.MissedBlocksGroup{Start: 0, End: 99, EmojiEnd: "🟢", DescEnd: "is recovered"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, server.bodies, 1)
+	assert.JSONEq(t, `{
+		"blocks": [
+			{
+				"type": "section",
+				"text": {
+					"type": "mrkdwn",
+					"text": "*❌ <https://mintscan.io/cosmos/validators/cosmosvaloper1xxx|test> has been jailed*"
+				}
+			},
+			{
+				"type": "section",
+				"text": {
+					"type": "mrkdwn",
+					"text": "*🟢 <https://mintscan.io/cosmos/validators/cosmosvaloper1xxx|test> is recovered*"
+				}
+			}
+		],
+		"replace_original": false,
+		"delete_original": false
+	}`, server.bodies[0])
+}
+
+func TestSendBlockKitTemplateOverride(t *testing.T) {
+	t.Parallel()
+
+	templatesDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(templatesDir, "slack", "events"), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(templatesDir, "slack", "events", "ValidatorJailed.md"),
+		[]byte(`:rotating_light: {{ .ValidatorLink }} got jailed`),
+		0o600,
+	))
+
+	server := newWebhookServer(t)
+	reporter := newTestReporter(t, server.URL, templatesDir)
+
+	err := reporter.Send(&types.Report{
+		Height: 100,
+		Events: []types.ReportEvent{
+			events.ValidatorJailed{Validator: &types.Validator{Moniker: "test", OperatorAddress: "cosmosvaloper1xxx"}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, server.bodies, 1)
+	assert.JSONEq(t, `{
+		"blocks": [
+			{
+				"type": "section",
+				"text": {
+					"type": "mrkdwn",
+					"text": ":rotating_light: <https://mintscan.io/cosmos/validators/cosmosvaloper1xxx|test> got jailed"
+				}
+			}
+		],
+		"replace_original": false,
+		"delete_original": false
+	}`, server.bodies[0])
+}
+
+func TestSendBlockKitSplitsMessages(t *testing.T) {
+	t.Parallel()
+
+	server := newWebhookServer(t)
+	reporter := newTestReporter(t, server.URL, "")
+
+	reportEvents := make([]types.ReportEvent, MaxBlocksInMessage+1)
+	for index := range reportEvents {
+		reportEvents[index] = events.ValidatorJailed{Validator: &types.Validator{Moniker: "test"}}
+	}
+
+	require.NoError(t, reporter.Send(&types.Report{Height: 100, Events: reportEvents}))
+	require.Len(t, server.bodies, 2)
+
+	for index, expected := range []int{MaxBlocksInMessage, 1} {
+		var message struct {
+			Blocks []map[string]any `json:"blocks"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(server.bodies[index]), &message))
+		require.Len(t, message.Blocks, expected)
+	}
+}
+
+func TestCommandRepliesAreLocalized(t *testing.T) {
+	t.Parallel()
+
+	reporter := newTestReporterWithLanguage(t, "https://example.com", "", constants.LanguageRussian)
+
+	recorder := httptest.NewRecorder()
+	reporter.GetUnsubscribeCommand().Handler(recorder, slack.SlashCommand{Command: "/unsubscribe", Text: "cosmosvaloper1xxx"})
+
+	var message struct {
+		Blocks []struct {
+			Text struct {
+				Text string `json:"text"`
+			} `json:"text"`
+		} `json:"blocks"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &message))
+	require.Len(t, message.Blocks, 1)
+	assert.Contains(t, message.Blocks[0].Text.Text, "`cosmosvaloper1xxx`")
+	assert.NotContains(t, message.Blocks[0].Text.Text, "Could not find")
+}
@@ -0,0 +1,80 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"net/http"
+	"sort"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetStatusCommand() *Command {
+	return &Command{
+		Name: "status",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "status")
+
+			operatorAddresses := reporter.Manager.GetValidatorsForNotifier(reporter.Name(), c.UserID)
+			if len(operatorAddresses) == 0 {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"You are not subscribed to any validator's notifications on %s.",
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+			if !found {
+				reporter.Logger.Info().
+					Msg("No older snapshot on slack status query!")
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Error getting your validators status"))
+				return
+			}
+
+			userEntries := snapshot.Entries.ByValidatorAddresses(operatorAddresses)
+
+			entries := make([]statusEntry, len(userEntries))
+
+			for index, entry := range userEntries {
+				entries[index] = statusEntry{
+					IsActive:  entry.IsActive,
+					Validator: entry.Validator,
+					Link:      reporter.Config.ExplorerConfig.GetValidatorLink(entry.Validator),
+				}
+
+				if entry.IsActive && !entry.Validator.Jailed {
+					signatureInfo, err := reporter.Manager.GetValidatorMissedBlocks(entry.Validator)
+					entries[index].Error = err
+					entries[index].SigningInfo = signatureInfo
+				}
+			}
+
+			sort.Slice(entries, func(i, j int) bool {
+				first := entries[i]
+				second := entries[j]
+
+				if first.Validator.Jailed != second.Validator.Jailed {
+					return utils.BoolToFloat64(second.Validator.Jailed)-utils.BoolToFloat64(first.Validator.Jailed) > 0
+				}
+
+				if first.IsActive != second.IsActive {
+					return utils.BoolToFloat64(second.IsActive)-utils.BoolToFloat64(first.IsActive) > 0
+				}
+
+				return second.Validator.VotingPowerPercent < first.Validator.VotingPowerPercent
+			})
+
+			template, err := reporter.GetUserTemplatesManager(c.UserID).Render("Status", statusRender{
+				ChainConfig: reporter.Config,
+				Entries:     entries,
+			})
+			if err != nil {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Could not render template"))
+				return
+			}
+			reporter.BotRespond(w, c, template)
+		},
+	}
+}
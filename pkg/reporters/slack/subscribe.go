@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"net/http"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetSubscribeCommand() *Command {
+	return &Command{
+		Name: "subscribe",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "subscribe")
+
+			args := strings.Fields(c.Text)
+			if len(args) < 1 {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Usage: %s <validator address> [--min <missed blocks %%>] [--events <event1,event2>]",
+					c.Command,
+				))
+				return
+			}
+
+			address := args[0]
+
+			filters, err := types.ParseNotifierFilters(args[1:])
+			if err != nil {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Could not parse filters: %s", err))
+				return
+			}
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			added := reporter.Manager.AddNotifier(
+				address,
+				reporter.Name(),
+				c.UserID,
+				c.UserName,
+				filters,
+			)
+
+			if !added {
+				if len(args) == 1 || !reporter.Manager.SetNotifierFilters(address, reporter.Name(), c.UserID, filters) {
+					reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "You are already subscribed to this validator's notifications"))
+					return
+				}
+
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Updated validator's notifications filters on %s: %s, notifying about %s",
+					reporter.Config.GetName(),
+					validatorLinkSerialized,
+					filters.String(),
+				))
+				return
+			}
+
+			reporter.BotRespond(w, c, reporter.TranslateReply(
+				c.UserID,
+				"Subscribed to validator's notifications on %s: %s, notifying about %s",
+				reporter.Config.GetName(),
+				validatorLinkSerialized,
+				filters.String(),
+			))
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package slack
+
+import (
+	"main/pkg/constants"
+	"net/http"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetUnsubscribeCommand() *Command {
+	return &Command{
+		Name: "unsubscribe",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "unsubscribe")
+
+			args := strings.Fields(c.Text)
+			if len(args) < 1 {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Usage: %s <validator address>", c.Command))
+				return
+			}
+
+			address := args[0]
+
+			validator, found := reporter.Manager.GetValidator(address)
+			if !found {
+				reporter.BotRespond(w, c, reporter.TranslateReply(
+					c.UserID,
+					"Could not find a validator with address %s on %s",
+					SerializeCode(address),
+					reporter.Config.GetName(),
+				))
+				return
+			}
+
+			removed := reporter.Manager.RemoveNotifier(address, reporter.Name(), c.UserID)
+
+			if !removed {
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "You are not subscribed to this validator's notifications"))
+				return
+			}
+
+			validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+			validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+			reporter.BotRespond(w, c, reporter.TranslateReply(
+				c.UserID,
+				"Unsubscribed from validator's notifications on %s: %s",
+				reporter.Config.GetName(),
+				validatorLinkSerialized,
+			))
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package slack
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"net/http"
+	"sort"
+
+	"github.com/slack-go/slack"
+)
+
+func (reporter *Reporter) GetValidatorsCommand() *Command {
+	return &Command{
+		Name: "validators",
+		Handler: func(w http.ResponseWriter, c slack.SlashCommand) {
+			reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.SlackReporterName, "validators")
+
+			snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
+			if !found {
+				reporter.Logger.Info().
+					Msg("No older snapshot on slack validators query!")
+				reporter.BotRespond(w, c, reporter.TranslateReply(c.UserID, "Error getting validators list"))
+				return
+			}
+
+			validatorEntries := snapshot.Entries.ToSlice()
+			activeValidatorsEntries := utils.Filter(validatorEntries, func(v *types.Entry) bool {
+				return v.IsActive
+			})
+
+			sort.Slice(activeValidatorsEntries, func(firstIndex, secondIndex int) bool {
+				first := activeValidatorsEntries[firstIndex]
+				second := activeValidatorsEntries[secondIndex]
+
+				return first.SignatureInfo.GetNotSigned() < second.SignatureInfo.GetNotSigned()
+			})
+
+			render := missingValidatorsRender{
+				Config: reporter.Config,
+				Validators: utils.Map(activeValidatorsEntries, func(v *types.Entry) missingValidatorsEntry {
+					link := reporter.Config.ExplorerConfig.GetValidatorLink(v.Validator)
+					group, _, _ := reporter.Config.MissedBlocksGroups.GetGroup(v.SignatureInfo.GetNotSigned())
+					link.Text = fmt.Sprintf("%s %s", group.EmojiEnd, v.Validator.Moniker)
+
+					return missingValidatorsEntry{
+						Validator:    v.Validator,
+						Link:         link,
+						NotSigned:    v.SignatureInfo.GetNotSigned(),
+						BlocksWindow: reporter.Config.BlocksWindow,
+					}
+				}),
+			}
+
+			template, err := reporter.GetUserTemplatesManager(c.UserID).Render("Validators", render)
+			if err != nil {
+				reporter.Logger.Error().Err(err).Msg("Error rendering missing")
+				return
+			}
+
+			reporter.BotRespond(w, c, template)
+		},
+	}
+}
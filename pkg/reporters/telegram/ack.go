@@ -0,0 +1,116 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+	"main/pkg/i18n"
+	templatesPkg "main/pkg/templates"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+const AckButtonUnique = "ack"
+
+func SerializeSender(sender *tele.User) string {
+	if sender.Username != "" {
+		return "@" + sender.Username
+	}
+
+	return sender.FirstName
+}
+
+// GetAckMarkup returns an inline keyboard with an "Acknowledge" button for each
+// validator in the report having an incident nobody took yet, or nil if there are none.
+func (reporter *Reporter) GetAckMarkup(reportEvents []types.ReportEvent) *tele.ReplyMarkup {
+	markup := &tele.ReplyMarkup{}
+	rows := make([]tele.Row, 0)
+	added := make(map[int64]bool)
+
+	for _, event := range reportEvents {
+		validator := event.GetValidator()
+
+		incident, found := reporter.Manager.GetIncident(validator.OperatorAddress)
+		if !found || incident.IsAcked() || added[incident.ID] {
+			continue
+		}
+
+		added[incident.ID] = true
+		rows = append(rows, markup.Row(markup.Data(
+			reporter.TemplatesManager.Translate("Acknowledge %s", validator.Moniker),
+			AckButtonUnique,
+			reporter.Config.Name,
+			strconv.FormatInt(incident.ID, 10),
+		)))
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	markup.Inline(rows...)
+	return markup
+}
+
+// HandleAck routes the "Acknowledge" button press to the chain reporter,
+// as its data is formatted as "<chain>|<incident ID>".
+func (b *Bot) HandleAck(c tele.Context) error {
+	chain, incidentID, _ := strings.Cut(c.Callback().Data, "|")
+
+	reporter := b.FindReporter(chain)
+	if reporter == nil {
+		return c.Respond(&tele.CallbackResponse{
+			Text: b.Reporters[0].TemplatesManager.Translate("Unknown chain: %s", chain),
+		})
+	}
+
+	return reporter.HandleAck(c, incidentID)
+}
+
+func (reporter *Reporter) HandleAck(c tele.Context, incidentIDRaw string) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("incident", incidentIDRaw).
+		Msg("Got incident acknowledgement")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "ack")
+
+	localizer := reporter.GetUserLocalizer(strconv.FormatInt(c.Sender().ID, 10))
+
+	incidentID, err := strconv.ParseInt(incidentIDRaw, 10, 64)
+	if err != nil {
+		return c.Respond(&tele.CallbackResponse{Text: localizer.Translate("Invalid incident ID")})
+	}
+
+	ackedBy := SerializeSender(c.Sender())
+
+	incident, err := reporter.Manager.AckIncident(incidentID, ackedBy)
+	if err != nil {
+		return c.Respond(&tele.CallbackResponse{Text: localizer.Translate("Could not acknowledge: %s", err)})
+	}
+
+	if incident.AckedBy != ackedBy {
+		return c.Respond(&tele.CallbackResponse{Text: localizer.Translate("Already acknowledged by %s", incident.AckedBy)})
+	}
+
+	if err := c.Respond(&tele.CallbackResponse{Text: localizer.Translate("Acknowledged")}); err != nil {
+		reporter.Logger.Error().Err(err).Msg("Could not respond to callback")
+	}
+
+	validatorLink := SerializeCode(incident.OperatorAddress)
+	if validator, found := reporter.Manager.GetValidator(incident.OperatorAddress); found {
+		validatorLink = reporter.TemplatesManager.SerializeLink(
+			reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+		)
+	}
+
+	// the reply is posted in the chat, so it is in the chain language
+	return reporter.BotReply(c, string(templatesPkg.TranslateHTML(
+		i18n.GetLocalizer(reporter.Config.Language),
+		"%s is handling the incident of %s on %s",
+		ackedBy,
+		validatorLink,
+		reporter.Config.GetName(),
+	)))
+}
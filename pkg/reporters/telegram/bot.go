@@ -0,0 +1,245 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	tele "gopkg.in/telebot.v3"
+	"gopkg.in/telebot.v3/middleware"
+)
+
+type Handler func(reporter *Reporter, c tele.Context) error
+
+type Bot struct {
+	Token   string
+	Admins  []int64
+	Version string
+
+	TelegramBot *tele.Bot
+	Webhook     *WebhookServer
+	Logger      zerolog.Logger
+	Reporters   []*Reporter
+
+	once sync.Once
+}
+
+// chainContext hides the chain name argument from the chain reporter's handlers.
+type chainContext struct {
+	tele.Context
+	text string
+}
+
+func (c chainContext) Text() string {
+	return c.text
+}
+
+func (c chainContext) Args() []string {
+	return strings.Fields(c.text)[1:]
+}
+
+func NewBot(
+	token string,
+	admins []int64,
+	webhook *WebhookServer,
+	version string,
+	logger zerolog.Logger,
+) *Bot {
+	return &Bot{
+		Token:   token,
+		Admins:  admins,
+		Webhook: webhook,
+		Version: version,
+		Logger:  logger.With().Str("component", "telegram_bot").Logger(),
+	}
+}
+
+func (b *Bot) AddReporter(reporter *Reporter) {
+	b.Reporters = append(b.Reporters, reporter)
+}
+
+func (b *Bot) IsShared() bool {
+	return len(b.Reporters) > 1
+}
+
+func (b *Bot) IsAdmin(userID int64) bool {
+	return utils.Contains(b.Admins, userID)
+}
+
+func (b *Bot) GetChainNames() []string {
+	return utils.Map(b.Reporters, func(reporter *Reporter) string {
+		return reporter.Config.Name
+	})
+}
+
+func (b *Bot) FindReporter(chain string) *Reporter {
+	reporter, _ := utils.Find(b.Reporters, func(reporter *Reporter) bool {
+		return strings.EqualFold(reporter.Config.Name, chain)
+	})
+
+	return reporter
+}
+
+func (b *Bot) Start() {
+	b.once.Do(b.start)
+}
+
+func (b *Bot) start() {
+	var poller tele.Poller = &tele.LongPoller{Timeout: 10 * time.Second}
+	if b.Webhook.Enabled() {
+		poller = b.Webhook.GetPoller(b.Token)
+	}
+
+	bot, err := tele.NewBot(tele.Settings{
+		Token:  b.Token,
+		Poller: poller,
+	})
+	if err != nil {
+		b.Logger.Warn().Err(err).Msg("Could not create Telegram bot")
+		return
+	}
+
+	if len(b.Admins) > 0 {
+		b.Logger.Debug().Msg("Using admins whitelist")
+		bot.Use(middleware.Whitelist(b.Admins...))
+	}
+
+	bot.Handle("/start", b.HandleHelp)
+	bot.Handle("/help", b.HandleHelp)
+	bot.Handle("/status", b.HandleStatus)
+	bot.Handle("/subscribe", b.WrapHandler((*Reporter).HandleSubscribe))
+	bot.Handle("/unsubscribe", b.WrapHandler((*Reporter).HandleUnsubscribe))
+	bot.Handle("/validators", b.WrapHandler((*Reporter).HandleListValidators))
+	bot.Handle("/missing", b.WrapHandler((*Reporter).HandleMissingValidators))
+	bot.Handle("/notifiers", b.WrapHandler((*Reporter).HandleNotifiers))
+	bot.Handle("/chart", b.WrapHandler((*Reporter).HandleChart))
+	bot.Handle("/params", b.WrapHandler((*Reporter).HandleParams))
+	bot.Handle("/config", b.WrapHandler((*Reporter).HandleParams))
+	bot.Handle("/delivery", b.WrapHandler((*Reporter).HandleDelivery))
+	bot.Handle("/lang", b.WrapHandler((*Reporter).HandleLanguage))
+	bot.Handle("/mute", b.WrapHandler((*Reporter).HandleMute))
+	bot.Handle("/unmute", b.WrapHandler((*Reporter).HandleUnmute))
+	bot.Handle("/quiet", b.WrapHandler((*Reporter).HandleQuietHours))
+	bot.Handle("/maintenance", b.WrapHandler((*Reporter).HandleMaintenance))
+	bot.Handle(&tele.Btn{Unique: AckButtonUnique}, b.HandleAck)
+
+	b.TelegramBot = bot
+	go b.TelegramBot.Start()
+
+	b.Logger.Info().Strs("chains", b.GetChainNames()).Msg("Telegram bot listening")
+}
+
+func (b *Bot) WrapHandler(handler Handler) tele.HandlerFunc {
+	return func(c tele.Context) error {
+		args := strings.Fields(c.Text())
+		if len(args) == 0 {
+			return nil
+		}
+
+		if len(args) > 1 {
+			if reporter := b.FindReporter(args[1]); reporter != nil {
+				text := strings.Join(append([]string{args[0]}, args[2:]...), " ")
+				return handler(reporter, chainContext{Context: c, text: text})
+			}
+		}
+
+		if !b.IsShared() {
+			return handler(b.Reporters[0], c)
+		}
+
+		return b.BotReply(c, html.EscapeString(fmt.Sprintf(
+			"Please specify the chain as the first argument: %s <chain> ...\nAvailable chains: %s",
+			args[0],
+			strings.Join(b.GetChainNames(), ", "),
+		)))
+	}
+}
+
+// BotSend sends the message split into chunks, to the forum topic if it's set,
+// passing the reply markup along with the last one.
+func (b *Bot) BotSend(chat int64, topic int, msg string, markup *tele.ReplyMarkup) error {
+	if b.TelegramBot == nil {
+		return fmt.Errorf("telegram bot is not initialized")
+	}
+
+	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
+
+	for index, message := range messages {
+		var messageMarkup *tele.ReplyMarkup
+		if index == len(messages)-1 {
+			messageMarkup = markup
+		}
+
+		if _, err := b.send(chat, topic, message, messageMarkup); err != nil {
+			b.Logger.Error().Err(err).Msg("Could not send Telegram message")
+			return err
+		}
+	}
+	return nil
+}
+
+// BotSendMessage sends a single message and returns its ID, so it can be edited later.
+func (b *Bot) BotSendMessage(chat int64, topic int, msg string, markup *tele.ReplyMarkup) (string, error) {
+	if b.TelegramBot == nil {
+		return "", fmt.Errorf("telegram bot is not initialized")
+	}
+
+	message, err := b.send(chat, topic, msg, markup)
+	if err != nil {
+		b.Logger.Error().Err(err).Msg("Could not send Telegram message")
+		return "", err
+	}
+
+	return strconv.Itoa(message.ID), nil
+}
+
+func (b *Bot) BotEditMessage(chat int64, messageID string, msg string, markup *tele.ReplyMarkup) error {
+	if b.TelegramBot == nil {
+		return fmt.Errorf("telegram bot is not initialized")
+	}
+
+	_, err := b.TelegramBot.Edit(
+		&tele.StoredMessage{
+			MessageID: messageID,
+			ChatID:    chat,
+		},
+		msg,
+		tele.ModeHTML,
+		tele.NoPreview,
+		markup,
+	)
+	return err
+}
+
+func (b *Bot) send(chat int64, topic int, msg string, markup *tele.ReplyMarkup) (*tele.Message, error) {
+	if topic != 0 {
+		return b.sendToTopic(chat, topic, msg, markup)
+	}
+
+	return b.TelegramBot.Send(
+		&tele.User{
+			ID: chat,
+		},
+		msg,
+		tele.ModeHTML,
+		tele.NoPreview,
+		markup,
+	)
+}
+
+func (b *Bot) BotReply(c tele.Context, msg string) error {
+	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
+
+	for _, message := range messages {
+		if err := c.Reply(message, tele.ModeHTML, tele.NoPreview); err != nil {
+			b.Logger.Error().Err(err).Msg("Could not send Telegram message")
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package telegram
+
+import (
+	"bytes"
+	chartPkg "main/pkg/chart"
+	"main/pkg/constants"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) HandleChart(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got chart query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "chart")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
+	args := strings.Split(c.Text(), " ")
+	if len(args) < 2 {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Usage: %s <validator address>", args[0]))
+	}
+
+	address := args[1]
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	chart := chartPkg.NewBlocksChart(
+		reporter.Manager.GetValidatorBlocksSignatures(validator),
+		reporter.Config.GetBlocksMissCount(),
+	)
+	if int64(len(chart.Signatures))-chart.Count(constants.BlockStatusUnknown) < 2 {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Not enough blocks on %s to draw a chart yet, try again later.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	image, err := chart.Render()
+	if err != nil {
+		reporter.Logger.Error().Err(err).Msg("Could not render chart")
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Could not render chart"))
+	}
+
+	render := chartRender{
+		Config:             reporter.Config,
+		Link:               reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+		BlocksCount:        len(chart.Signatures),
+		Signed:             chart.Count(constants.BlockStatusSigned),
+		Missed:             chart.Count(constants.BlockStatusMissed),
+		NotActive:          chart.Count(constants.BlockStatusNotActive),
+		MissedBlocksToJail: chart.MissedBlocksToJail,
+	}
+
+	if render.Missed < render.MissedBlocksToJail {
+		render.TimeToJail = reporter.Manager.GetTimeTillJail(render.Missed)
+	}
+
+	caption, err := reporter.GetUserTemplatesManager(userID).Render("Chart", render)
+	if err != nil {
+		return err
+	}
+
+	return c.Reply(&tele.Photo{File: tele.FromReader(bytes.NewReader(image)), Caption: caption}, tele.ModeHTML)
+}
@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) HandleDelivery(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got delivery query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "delivery")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+	deliveryModes := utils.Map(constants.GetDeliveryModes(), func(mode constants.DeliveryMode) string {
+		return string(mode)
+	})
+
+	args := strings.Split(c.Text(), " ")
+	if len(args) < 2 || !utils.Contains(deliveryModes, args[1]) {
+		current := reporter.TranslateReply(
+			userID,
+			"Your current delivery mode on %s is %s.",
+			reporter.Config.GetName(),
+			SerializeCode(string(reporter.Manager.GetNotifierDeliveryMode(reporter.Name(), userID))),
+		)
+		usage := reporter.TranslateReply(userID, "Usage: %s <%s>", args[0], strings.Join(deliveryModes, "|"))
+
+		return reporter.BotReply(c, current+"\n"+usage)
+	}
+
+	deliveryMode := constants.DeliveryMode(args[1])
+
+	if !reporter.Manager.SetNotifierDeliveryMode(reporter.Name(), userID, deliveryMode) {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"You are not subscribed to any validator's notifications on %s.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	response := reporter.TranslateReply(
+		userID,
+		"Delivery mode on %s is set to %s.",
+		reporter.Config.GetName(),
+		SerializeCode(string(deliveryMode)),
+	)
+
+	if deliveryMode != constants.DeliveryModeChannel {
+		response += "\n" + reporter.TranslateReply(
+			userID,
+			"Make sure you have started a private chat with the bot, otherwise it won't be able to message you.",
+		)
+	}
+
+	return reporter.BotReply(c, response)
+}
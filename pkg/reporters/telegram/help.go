@@ -2,22 +2,30 @@ package telegram
 
 import (
 	"main/pkg/constants"
+	"strconv"
 
 	tele "gopkg.in/telebot.v3"
 )
 
-func (reporter *Reporter) HandleHelp(c tele.Context) error {
-	reporter.Logger.Info().
+func (b *Bot) HandleHelp(c tele.Context) error {
+	b.Logger.Info().
 		Str("sender", c.Sender().Username).
 		Str("text", c.Text()).
 		Msg("Got help query")
 
-	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "help")
+	for _, reporter := range b.Reporters {
+		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "help")
+	}
+
+	render := helpRender{Version: b.Version}
+	if b.IsShared() {
+		render.Chains = b.GetChainNames()
+	}
 
-	template, err := reporter.TemplatesManager.Render("Help", reporter.Version)
+	template, err := b.Reporters[0].GetUserTemplatesManager(strconv.FormatInt(c.Sender().ID, 10)).Render("Help", render)
 	if err != nil {
 		return err
 	}
 
-	return reporter.BotReply(c, template)
+	return b.BotReply(c, template)
 }
@@ -0,0 +1,132 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/config"
+	"main/pkg/i18n"
+	templatesPkg "main/pkg/templates"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+)
+
+// Telegram limits forum topic names to 128 characters.
+const MaxTopicNameLength = 128
+
+// SendIncidentMessage edits the message of the validator's ongoing incident,
+// or posts a new one if there is none yet or it cannot be edited.
+// If the destination has incident topics enabled, each incident gets its own forum topic,
+// which is closed once the incident is over.
+func (reporter *Reporter) SendIncidentMessage(
+	destination config.TelegramDestination,
+	message *types.IncidentMessage,
+	event types.ReportEvent,
+	text string,
+) error {
+	markup := reporter.GetAckMarkup([]types.ReportEvent{event})
+
+	if !message.IsNew() {
+		err := reporter.Bot.BotEditMessage(destination.Chat, message.MessageID, text, markup)
+		if err == nil {
+			reporter.MaybeCloseIncidentTopic(destination, message)
+			return reporter.Manager.SaveIncidentMessage(message)
+		}
+
+		reporter.Logger.Warn().
+			Err(err).
+			Int64("chat", destination.Chat).
+			Str("message", message.MessageID).
+			Msg("Could not edit incident message, sending a new one")
+	}
+
+	if message.ThreadID == "" && destination.IncidentTopics && !message.Resolved {
+		topic, err := reporter.Bot.CreateTopic(destination.Chat, reporter.GetIncidentTopicName(event))
+		if err != nil {
+			reporter.Logger.Warn().
+				Err(err).
+				Int64("chat", destination.Chat).
+				Msg("Could not create incident topic, posting to the chat instead")
+		} else {
+			message.ThreadID = strconv.Itoa(topic)
+		}
+	}
+
+	topic := destination.Topic
+	if message.ThreadID != "" {
+		if threadID, err := strconv.Atoi(message.ThreadID); err == nil {
+			topic = threadID
+		}
+	}
+
+	messageID, err := reporter.Bot.BotSendMessage(destination.Chat, topic, text, markup)
+	if err != nil {
+		return err
+	}
+
+	message.MessageID = messageID
+	reporter.MaybeCloseIncidentTopic(destination, message)
+	return reporter.Manager.SaveIncidentMessage(message)
+}
+
+func (reporter *Reporter) MaybeCloseIncidentTopic(
+	destination config.TelegramDestination,
+	message *types.IncidentMessage,
+) {
+	if !message.Resolved || message.ThreadID == "" {
+		return
+	}
+
+	topic, err := strconv.Atoi(message.ThreadID)
+	if err != nil {
+		return
+	}
+
+	if err := reporter.Bot.CloseTopic(destination.Chat, topic); err != nil {
+		reporter.Logger.Warn().
+			Err(err).
+			Int64("chat", destination.Chat).
+			Int("topic", topic).
+			Msg("Could not close incident topic")
+	}
+}
+
+func (reporter *Reporter) GetIncidentTopicName(event types.ReportEvent) string {
+	validator := event.GetValidator()
+
+	name := []rune(fmt.Sprintf("Incident: %s", validator.Moniker))
+	if reporter.Bot.IsShared() {
+		name = []rune(fmt.Sprintf("Incident: %s on %s", validator.Moniker, reporter.Config.GetName()))
+	}
+
+	if len(name) > MaxTopicNameLength {
+		name = name[:MaxTopicNameLength]
+	}
+
+	return string(name)
+}
+
+func (reporter *Reporter) SerializeIncidentMessage(
+	message *types.IncidentMessage,
+	event types.ReportEvent,
+) string {
+	var sb strings.Builder
+
+	if reporter.Bot.IsShared() {
+		sb.WriteString(fmt.Sprintf("<strong>%s</strong>\n", reporter.Config.GetName()))
+	}
+
+	sb.WriteString(reporter.TemplatesManager.SerializeEvent(reporter.SerializeEvent(event)) + "\n")
+	sb.WriteString(string(templatesPkg.TranslateHTML(
+		i18n.GetLocalizer(reporter.Config.Language),
+		"Progression: %s",
+		message.SerializeProgression(reporter.TemplatesManager.Translate),
+	)))
+
+	if message.Resolved {
+		emoji, line := message.GetClosingLine()
+		sb.WriteString("\n<strong>" + emoji + " " + html.EscapeString(reporter.TemplatesManager.Translate(line)) + "</strong>")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,60 @@
+package telegram
+
+import (
+	"fmt"
+	"html"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) HandleLanguage(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got language query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "lang")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+	languages := utils.Map(constants.GetLanguages(), func(language constants.Language) string {
+		return string(language)
+	})
+
+	args := strings.Split(c.Text(), " ")
+	if len(args) < 2 || !utils.Contains(languages, args[1]) {
+		language := reporter.Manager.GetNotifierLanguage(reporter.Name(), userID)
+		if language == "" {
+			language = reporter.Config.Language
+		}
+
+		return reporter.BotReply(c, fmt.Sprintf(
+			"%s\n%s",
+			html.EscapeString(reporter.TemplatesManager.WithLanguage(language).Translate(
+				"Your current language on %s is %s.",
+				reporter.Config.GetName(),
+				language,
+			)),
+			html.EscapeString(fmt.Sprintf("Usage: %s <%s>", args[0], strings.Join(languages, "|"))),
+		))
+	}
+
+	language := constants.Language(args[1])
+	manager := reporter.TemplatesManager.WithLanguage(language)
+
+	if !reporter.Manager.SetNotifierLanguage(reporter.Name(), userID, language) {
+		return reporter.BotReply(c, html.EscapeString(manager.Translate(
+			"You are not subscribed to any validator's notifications on %s.",
+			reporter.Config.GetName(),
+		)))
+	}
+
+	return reporter.BotReply(c, html.EscapeString(manager.Translate(
+		"Language on %s is set to %s.",
+		reporter.Config.GetName(),
+		language,
+	)))
+}
@@ -0,0 +1,120 @@
+package telegram
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+	"time"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) HandleMaintenance(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got maintenance query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "maintenance")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
+	args := strings.Fields(c.Text())
+	if len(args) < 2 {
+		return reporter.BotReply(c, reporter.SerializeMaintenanceWindows(userID, args[0]))
+	}
+
+	if !reporter.Bot.IsAdmin(c.Sender().ID) {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Only bot admins can manage maintenance windows."))
+	}
+
+	address := args[1]
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	if len(args) == 3 && args[2] == "off" {
+		if !reporter.Manager.RemoveMaintenanceWindow(address) {
+			return reporter.BotReply(c, reporter.TranslateReply(userID, "This validator has no maintenance window"))
+		}
+
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Cancelled maintenance window on %s: %s",
+			reporter.Config.GetName(),
+			validatorLinkSerialized,
+		))
+	}
+
+	window, err := types.ParseMaintenanceWindow(address, args[2:], time.Now())
+	if err != nil {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Could not parse maintenance window: %s", err))
+	}
+
+	window.CreatedBy = SerializeSender(c.Sender())
+
+	if err := reporter.Manager.SetMaintenanceWindow(window); err != nil {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Could not save maintenance window"))
+	}
+
+	return reporter.BotReply(c, reporter.TranslateReply(
+		userID,
+		"Declared maintenance window on %s for %s: %s",
+		reporter.Config.GetName(),
+		validatorLinkSerialized,
+		SerializeCode(window.String()),
+	))
+}
+
+func (reporter *Reporter) SerializeMaintenanceWindows(userID string, command string) string {
+	var sb strings.Builder
+
+	windows := reporter.Manager.GetMaintenanceWindows()
+	if len(windows) == 0 {
+		sb.WriteString(reporter.TranslateReply(userID, "There are no maintenance windows on %s.", reporter.Config.GetName()))
+	} else {
+		sb.WriteString(fmt.Sprintf(
+			"<strong>%s</strong>",
+			reporter.TranslateReply(userID, "Maintenance windows on %s:", reporter.Config.GetName()),
+		))
+	}
+
+	sb.WriteString("\n")
+
+	for _, window := range windows {
+		link := SerializeCode(window.OperatorAddress)
+		if validator, found := reporter.Manager.GetValidator(window.OperatorAddress); found {
+			link = reporter.TemplatesManager.SerializeLink(reporter.Config.ExplorerConfig.GetValidatorLink(validator))
+		}
+
+		sb.WriteString(reporter.TranslateReply(
+			userID,
+			"- %s: %s (by %s)",
+			link,
+			window.String(),
+			window.CreatedBy,
+		) + "\n")
+	}
+
+	sb.WriteString(reporter.TranslateReply(
+		userID,
+		"Usage: %s <validator address> <duration> [<start time>], %s <validator address> <start height>-<end height>, or %s <validator address> off",
+		command,
+		command,
+		command,
+	))
+
+	return sb.String()
+}
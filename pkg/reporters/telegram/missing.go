@@ -6,6 +6,7 @@ import (
 	"main/pkg/types"
 	"main/pkg/utils"
 	"sort"
+	"strconv"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -24,7 +25,7 @@ func (reporter *Reporter) HandleMissingValidators(c tele.Context) error {
 			Str("sender", c.Sender().Username).
 			Str("text", c.Text()).
 			Msg("No older snapshot on telegram validators query!")
-		return reporter.BotReply(c, "Error getting validators list")
+		return reporter.BotReply(c, reporter.TranslateReply(strconv.FormatInt(c.Sender().ID, 10), "Error getting validators list"))
 	}
 
 	validatorEntries := snapshot.Entries.ToSlice()
@@ -60,7 +61,7 @@ func (reporter *Reporter) HandleMissingValidators(c tele.Context) error {
 		}),
 	}
 
-	template, err := reporter.TemplatesManager.Render("Missing", render)
+	template, err := reporter.GetUserTemplatesManager(strconv.FormatInt(c.Sender().ID, 10)).Render("Missing", render)
 	if err != nil {
 		return err
 	}
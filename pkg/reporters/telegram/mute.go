@@ -0,0 +1,121 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) HandleMute(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got mute query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "mute")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
+	args := strings.Split(c.Text(), " ")
+	if len(args) < 3 {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Usage: %s <validator address> <duration>, for example: %s <validator address> 2h",
+			args[0],
+			args[0],
+		))
+	}
+
+	address := args[1]
+	duration, err := utils.ParseDuration(args[2])
+	if err != nil || duration <= 0 {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Invalid duration %s, use values like 30m, 2h or 1d",
+			SerializeCode(args[2]),
+		))
+	}
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	if !reporter.Manager.MuteNotifier(address, reporter.Name(), userID, time.Now().Add(duration)) {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "You are not subscribed to this validator's notifications"))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	return reporter.BotReply(c, reporter.TranslateReply(
+		userID,
+		"Muted validator's notifications on %s for %s: %s",
+		reporter.Config.GetName(),
+		reporter.GetUserLocalizer(userID).FormatDuration(duration),
+		validatorLinkSerialized,
+	))
+}
+
+func (reporter *Reporter) HandleUnmute(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got unmute query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "unmute")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
+	args := strings.Split(c.Text(), " ")
+	if len(args) < 2 {
+		if !reporter.Manager.UnmuteNotifier("", reporter.Name(), userID) {
+			return reporter.BotReply(c, reporter.TranslateReply(
+				userID,
+				"You are not subscribed to any validator's notifications on %s.",
+				reporter.Config.GetName(),
+			))
+		}
+
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Unmuted all validators' notifications on %s.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	address := args[1]
+
+	validator, found := reporter.Manager.GetValidator(address)
+	if !found {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
+		))
+	}
+
+	if !reporter.Manager.UnmuteNotifier(address, reporter.Name(), userID) {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "You are not subscribed to this validator's notifications"))
+	}
+
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
+	return reporter.BotReply(c, reporter.TranslateReply(
+		userID,
+		"Unmuted validator's notifications on %s: %s",
+		reporter.Config.GetName(),
+		validatorLinkSerialized,
+	))
+}
@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"main/pkg/constants"
+	"strconv"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -30,7 +31,7 @@ func (reporter *Reporter) HandleNotifiers(c tele.Context) error {
 		})
 	}
 
-	template, err := reporter.TemplatesManager.Render("Notifiers", notifierRender{
+	template, err := reporter.GetUserTemplatesManager(strconv.FormatInt(c.Sender().ID, 10)).Render("Notifiers", notifierRender{
 		Entries: entries,
 		Config:  reporter.Config,
 	})
@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"main/pkg/constants"
+	"strconv"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -14,6 +15,8 @@ func (reporter *Reporter) HandleParams(c tele.Context) error {
 
 	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "params")
 
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
 	blockTime := reporter.Manager.GetBlockTime()
 	maxTimeToJail := reporter.Manager.GetTimeTillJail(0)
 
@@ -23,15 +26,16 @@ func (reporter *Reporter) HandleParams(c tele.Context) error {
 			Str("sender", c.Sender().Username).
 			Str("text", c.Text()).
 			Msg("No older snapshot on telegram params query!")
-		return reporter.BotReply(c, "Error getting params")
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Error getting params"))
 	}
 
 	activeValidators := snapshot.Entries.GetActive()
-	template, err := reporter.TemplatesManager.Render("Params", paramsRender{
+	template, err := reporter.GetUserTemplatesManager(userID).Render("Params", paramsRender{
 		Config:          reporter.Config,
 		BlockTime:       blockTime,
 		MaxTimeToJail:   maxTimeToJail,
 		ValidatorsCount: len(activeValidators),
+		Localizer:       reporter.GetUserLocalizer(userID),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,65 @@
+package telegram
+
+import (
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strconv"
+	"strings"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+func (reporter *Reporter) HandleQuietHours(c tele.Context) error {
+	reporter.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got quiet hours query")
+
+	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "quiet")
+
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
+	args := strings.Split(c.Text(), " ")
+	if len(args) != 2 && len(args) != 4 || len(args) == 2 && args[1] != "off" {
+		current := reporter.TranslateReply(
+			userID,
+			"Your current quiet hours on %s: %s.",
+			reporter.Config.GetName(),
+			SerializeCode(reporter.Manager.GetNotifierQuietHours(reporter.Name(), userID).String()),
+		)
+		usage := reporter.TranslateReply(
+			userID,
+			"Usage: %s <HH:MM> <HH:MM> <timezone>, for example: %s 22:00 07:00 Europe/Berlin, or %s off",
+			args[0],
+			args[0],
+			args[0],
+		)
+
+		return reporter.BotReply(c, current+"\n"+usage)
+	}
+
+	var quietHours types.QuietHours
+	if len(args) == 4 {
+		parsed, err := types.ParseQuietHours(args[1], args[2], args[3])
+		if err != nil {
+			return reporter.BotReply(c, reporter.TranslateReply(userID, "Could not set quiet hours: %s", err))
+		}
+
+		quietHours = parsed
+	}
+
+	if !reporter.Manager.SetNotifierQuietHours(reporter.Name(), userID, quietHours) {
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"You are not subscribed to any validator's notifications on %s.",
+			reporter.Config.GetName(),
+		))
+	}
+
+	return reporter.BotReply(c, reporter.TranslateReply(
+		userID,
+		"Quiet hours on %s are set to %s.",
+		reporter.Config.GetName(),
+		SerializeCode(quietHours.String()),
+	))
+}
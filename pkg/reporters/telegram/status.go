@@ -1,11 +1,13 @@
 package telegram
 
 import (
+	"errors"
 	"fmt"
 	"main/pkg/constants"
 	"main/pkg/utils"
 	"sort"
 	"strconv"
+	"strings"
 
 	tele "gopkg.in/telebot.v3"
 )
@@ -18,21 +20,31 @@ func (reporter *Reporter) HandleStatus(c tele.Context) error {
 
 	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "status")
 
-	operatorAddresses := reporter.Manager.GetValidatorsForNotifier(reporter.Name(), strconv.FormatInt(c.Sender().ID, 10))
-	if len(operatorAddresses) == 0 {
+	status, err := reporter.GetStatus(c.Sender().ID)
+	if err != nil {
+		reporter.Logger.Error().Err(err).Msg("Error getting validators status")
+		return reporter.BotReply(c, reporter.TranslateReply(strconv.FormatInt(c.Sender().ID, 10), "Error getting your validators status"))
+	}
+
+	if status == "" {
 		return reporter.BotReply(c, fmt.Sprintf(
 			"You are not subscribed to any validator's notifications on %s.",
 			reporter.Config.GetName(),
 		))
 	}
 
+	return reporter.BotReply(c, status)
+}
+
+func (reporter *Reporter) GetStatus(userID int64) (string, error) {
+	operatorAddresses := reporter.Manager.GetValidatorsForNotifier(reporter.Name(), strconv.FormatInt(userID, 10))
+	if len(operatorAddresses) == 0 {
+		return "", nil
+	}
+
 	snapshot, found := reporter.SnapshotManager.GetNewerSnapshot()
 	if !found {
-		reporter.Logger.Info().
-			Str("sender", c.Sender().Username).
-			Str("text", c.Text()).
-			Msg("No older snapshot on telegram status query!")
-		return reporter.BotReply(c, "Error getting your validators status")
+		return "", errors.New("no newer snapshot")
 	}
 
 	userEntries := snapshot.Entries.ByValidatorAddresses(operatorAddresses)
@@ -68,13 +80,57 @@ func (reporter *Reporter) HandleStatus(c tele.Context) error {
 		return second.Validator.VotingPowerPercent < first.Validator.VotingPowerPercent
 	})
 
-	template, err := reporter.TemplatesManager.Render("Status", statusRender{
+	template, err := reporter.GetUserTemplatesManager(strconv.FormatInt(userID, 10)).Render("Status", statusRender{
 		ChainConfig: reporter.Config,
 		Entries:     entries,
 	})
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	return template, nil
+}
+
+func (b *Bot) HandleStatus(c tele.Context) error {
+	b.Logger.Info().
+		Str("sender", c.Sender().Username).
+		Str("text", c.Text()).
+		Msg("Got status query")
+
+	reporters := b.Reporters
+	if args := strings.Fields(c.Text()); len(args) > 1 {
+		if reporter := b.FindReporter(args[1]); reporter != nil {
+			reporters = []*Reporter{reporter}
+		}
+	}
+
+	if len(reporters) == 1 {
+		return reporters[0].HandleStatus(c)
+	}
+
+	statuses := make([]string, 0)
+
+	for _, reporter := range reporters {
+		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "status")
+
+		status, err := reporter.GetStatus(c.Sender().ID)
+		if err != nil {
+			reporter.Logger.Error().Err(err).Msg("Error getting validators status")
+			statuses = append(statuses, fmt.Sprintf(
+				"Error getting your validators status on %s",
+				reporter.Config.GetName(),
+			))
+			continue
+		}
+
+		if status != "" {
+			statuses = append(statuses, status)
+		}
+	}
+
+	if len(statuses) == 0 {
+		return b.BotReply(c, "You are not subscribed to any validator's notifications.")
 	}
 
-	return reporter.BotReply(c, template)
+	return b.BotReply(c, strings.Join(statuses, "\n\n"))
 }
@@ -1,9 +1,8 @@
 package telegram
 
 import (
-	"fmt"
-	"html"
 	"main/pkg/constants"
+	"main/pkg/types"
 	"strconv"
 	"strings"
 
@@ -25,42 +24,64 @@ func (reporter *Reporter) HandleSubscribe(c tele.Context) error {
 		username = "@" + username
 	}
 
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
 	args := strings.Split(c.Text(), " ")
 	if len(args) < 2 {
-		return reporter.BotReply(c, html.EscapeString(fmt.Sprintf(
-			"Usage: %s <validator address>",
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Usage: %s <validator address> [--min <missed blocks %%>] [--events <event1,event2>]",
 			args[0],
-		)))
+		))
 	}
 
 	address := args[1]
 
+	filters, err := types.ParseNotifierFilters(args[2:])
+	if err != nil {
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Could not parse filters: %s", err))
+	}
+
 	validator, found := reporter.Manager.GetValidator(address)
 	if !found {
-		return reporter.BotReply(c, fmt.Sprintf(
-			"Could not find a validator with address <code>%s</code> on %s",
-			address,
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
 			reporter.Config.GetName(),
 		))
 	}
 
+	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
+	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+
 	added := reporter.Manager.AddNotifier(
 		address,
 		reporter.Name(),
-		strconv.FormatInt(c.Sender().ID, 10),
+		userID,
 		username,
+		filters,
 	)
 
 	if !added {
-		return reporter.BotReply(c, "You are already subscribed to this validator's notifications")
-	}
+		if len(args) == 2 || !reporter.Manager.SetNotifierFilters(address, reporter.Name(), userID, filters) {
+			return reporter.BotReply(c, reporter.TranslateReply(userID, "You are already subscribed to this validator's notifications"))
+		}
 
-	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
-	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Updated validator's notifications filters on %s: %s, notifying about %s",
+			reporter.Config.GetName(),
+			validatorLinkSerialized,
+			filters.String(),
+		))
+	}
 
-	return reporter.BotReply(c, fmt.Sprintf(
-		"Subscribed to validator's notifications on %s: %s",
+	return reporter.BotReply(c, reporter.TranslateReply(
+		userID,
+		"Subscribed to validator's notifications on %s: %s, notifying about %s",
 		reporter.Config.GetName(),
 		validatorLinkSerialized,
+		filters.String(),
 	))
 }
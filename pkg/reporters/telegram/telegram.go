@@ -1,32 +1,32 @@
 package telegram
 
 import (
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
 	"main/pkg/constants"
 	"main/pkg/events"
+	"main/pkg/i18n"
 	"main/pkg/metrics"
 	snapshotPkg "main/pkg/snapshot"
 	statePkg "main/pkg/state"
 	templatesPkg "main/pkg/templates"
 	"main/pkg/types"
+	"strconv"
 	"strings"
 	"time"
 
 	"main/pkg/config"
-	"main/pkg/utils"
 
 	"github.com/rs/zerolog"
 	tele "gopkg.in/telebot.v3"
-	"gopkg.in/telebot.v3/middleware"
 )
 
 type Reporter struct {
-	Token  string
-	Chat   int64
-	Admins []int64
+	Destinations []config.TelegramDestination
 
-	Version string
-
-	TelegramBot      *tele.Bot
+	Bot              *Bot
 	Logger           zerolog.Logger
 	Config           *config.ChainConfig
 	Manager          *statePkg.Manager
@@ -46,41 +46,43 @@ func NewReporter(
 	manager *statePkg.Manager,
 	metricsManager *metrics.Manager,
 	snapshotManager *snapshotPkg.Manager,
+	bot *Bot,
+	webhook *WebhookServer,
 ) *Reporter {
-	return &Reporter{
-		Token:            chainConfig.TelegramConfig.Token,
-		Chat:             chainConfig.TelegramConfig.Chat,
-		Admins:           chainConfig.TelegramConfig.Admins,
+	if bot == nil && chainConfig.TelegramConfig.Token != "" {
+		bot = NewBot(
+			chainConfig.TelegramConfig.Token,
+			chainConfig.TelegramConfig.Admins,
+			webhook,
+			version,
+			logger,
+		)
+	}
+
+	reporter := &Reporter{
+		Bot:              bot,
+		Destinations:     chainConfig.TelegramConfig.GetDestinations(),
 		Config:           chainConfig,
 		Logger:           logger.With().Str("component", "telegram_reporter").Logger(),
 		Manager:          manager,
 		MetricsManager:   metricsManager,
 		SnapshotManager:  snapshotManager,
-		TemplatesManager: templatesPkg.NewManager(logger, constants.TelegramReporterName),
-		Version:          version,
+		TemplatesManager: templatesPkg.NewManager(logger, constants.TelegramReporterName, chainConfig.TemplatesDir, chainConfig.Language),
 	}
+
+	if bot != nil && len(reporter.Destinations) > 0 {
+		bot.AddReporter(reporter)
+	}
+
+	return reporter
 }
 
 func (reporter *Reporter) Init() {
-	if reporter.Token == "" || reporter.Chat == 0 {
+	if !reporter.Enabled() {
 		reporter.Logger.Debug().Msg("Telegram credentials not set, not creating Telegram reporter")
 		return
 	}
 
-	bot, err := tele.NewBot(tele.Settings{
-		Token:  reporter.Token,
-		Poller: &tele.LongPoller{Timeout: 10 * time.Second},
-	})
-	if err != nil {
-		reporter.Logger.Warn().Err(err).Msg("Could not create Telegram bot")
-		return
-	}
-
-	if len(reporter.Admins) > 0 {
-		reporter.Logger.Debug().Msg("Using admins whitelist")
-		bot.Use(middleware.Whitelist(reporter.Admins...))
-	}
-
 	queries := []string{
 		"help",
 		"missing",
@@ -90,29 +92,25 @@ func (reporter *Reporter) Init() {
 		"subscribe",
 		"unsubscribe",
 		"validators",
+		"chart",
+		"delivery",
+		"lang",
+		"mute",
+		"unmute",
+		"quiet",
+		"maintenance",
+		"ack",
 	}
 
 	for _, query := range queries {
 		reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, query)
 	}
 
-	bot.Handle("/start", reporter.HandleHelp)
-	bot.Handle("/help", reporter.HandleHelp)
-	bot.Handle("/subscribe", reporter.HandleSubscribe)
-	bot.Handle("/unsubscribe", reporter.HandleUnsubscribe)
-	bot.Handle("/status", reporter.HandleStatus)
-	bot.Handle("/validators", reporter.HandleListValidators)
-	bot.Handle("/missing", reporter.HandleMissingValidators)
-	bot.Handle("/notifiers", reporter.HandleNotifiers)
-	bot.Handle("/params", reporter.HandleParams)
-	bot.Handle("/config", reporter.HandleParams)
-
-	reporter.TelegramBot = bot
-	go reporter.TelegramBot.Start()
+	reporter.Bot.Start()
 }
 
 func (reporter *Reporter) Enabled() bool {
-	return reporter.Token != "" && reporter.Chat != 0
+	return reporter.Bot != nil && len(reporter.Destinations) > 0
 }
 
 func (reporter *Reporter) GetStateManager() *statePkg.Manager {
@@ -121,11 +119,9 @@ func (reporter *Reporter) GetStateManager() *statePkg.Manager {
 
 func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
 	validator := event.GetValidator()
-	notifiers := reporter.Manager.GetNotifiersForReporter(validator.OperatorAddress, constants.TelegramReporterName)
-
 	eventToRender := types.RenderEventItem{
 		Event:         event,
-		Notifiers:     notifiers,
+		Notifiers:     reporter.Manager.GetNotifiersToMention(event, constants.TelegramReporterName),
 		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
 	}
 
@@ -133,65 +129,164 @@ func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEv
 		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
 	}
 
+	if incident, found := reporter.Manager.GetIncident(validator.OperatorAddress); found {
+		eventToRender.Incident = incident
+	}
+
 	return eventToRender
 }
 
 func (reporter *Reporter) Send(report *types.Report) error {
 	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
 
+	var errs []error
+
+	for _, destination := range reporter.Destinations {
+		destinationEvents := make([]types.ReportEvent, 0)
+
+		for _, event := range destination.Filter(report.Events) {
+			message, ok := reporter.Manager.GetIncidentMessage(
+				event,
+				reporter.Name(),
+				destination.GetKey(),
+			)
+			if !ok {
+				destinationEvents = append(destinationEvents, event)
+				continue
+			}
+
+			if err := report.Deliver("incident:"+destination.GetKey()+":"+message.OperatorAddress, func() (string, error) {
+				return reporter.SerializeIncidentMessage(message, event), nil
+			}, func(text string) error {
+				return reporter.SendIncidentMessage(destination, message, event, text)
+			}); err != nil {
+				reporter.Logger.Error().
+					Err(err).
+					Int64("chat", destination.Chat).
+					Msg("Could not send Telegram incident message")
+				errs = append(errs, err)
+			}
+		}
+
+		if len(destinationEvents) == 0 {
+			continue
+		}
+
+		if err := report.Deliver("chat:"+destination.GetKey(), func() (string, error) {
+			var sb strings.Builder
+
+			if reporter.Bot.IsShared() {
+				sb.WriteString(fmt.Sprintf("<strong>%s</strong>\n", reporter.Config.GetName()))
+			}
+
+			for _, event := range destinationEvents {
+				eventToRender := reporter.SerializeEvent(event)
+				sb.WriteString(reporter.TemplatesManager.SerializeEvent(eventToRender) + "\n")
+			}
+
+			return sb.String(), nil
+		}, func(reportString string) error {
+			reporter.Logger.Trace().
+				Int64("chat", destination.Chat).
+				Int("topic", destination.Topic).
+				Str("report", reportString).
+				Msg("Sending a report")
+
+			return reporter.BotSend(
+				destination.Chat,
+				destination.Topic,
+				reportString,
+				reporter.GetAckMarkup(destinationEvents),
+			)
+		}); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Int64("chat", destination.Chat).
+				Msg("Could not send Telegram message")
+			errs = append(errs, err)
+		}
+	}
+
+	for _, message := range reporter.Manager.GetDirectMessages(report, reporter.Name()) {
+		if err := report.Deliver("dm:"+message.UserID, func() (string, error) {
+			return reporter.SerializeDirectMessage(message), nil
+		}, func(content string) error {
+			return reporter.SendDirectMessage(message.UserID, content)
+		}); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("user", message.UserName).
+				Msg("Could not send Telegram direct message")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (reporter *Reporter) SerializeDirectMessage(message *types.DirectMessage) string {
+	manager := reporter.TemplatesManager.WithLanguage(message.Language)
+
 	var sb strings.Builder
 
-	for _, event := range report.Events {
+	sb.WriteString(manager.Translate("Updates on %s for validators you are subscribed to:", reporter.Config.GetName()) + "\n")
+
+	for _, event := range message.Events {
 		eventToRender := reporter.SerializeEvent(event)
-		sb.WriteString(reporter.TemplatesManager.SerializeEvent(eventToRender) + "\n")
+		eventToRender.Notifiers = nil
+		sb.WriteString(manager.SerializeEvent(eventToRender) + "\n")
 	}
 
-	reportString := sb.String()
-
-	reporter.Logger.Trace().Str("report", reportString).Msg("Sending a report")
+	return sb.String()
+}
 
-	if err := reporter.BotSend(reportString); err != nil {
-		reporter.Logger.Err(err).Msg("Could not send Telegram message")
+func (reporter *Reporter) SendDirectMessage(userID string, content string) error {
+	chat, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
 		return err
 	}
-	return nil
+
+	return reporter.BotSend(chat, 0, content, nil)
+}
+
+// GetUserTemplatesManager returns the templates manager rendering replies in the language
+// the user has chosen, or in the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserTemplatesManager(userID string) templatesPkg.Manager {
+	return reporter.TemplatesManager.WithLanguage(reporter.Manager.GetNotifierLanguage(reporter.Name(), userID))
+}
+
+// GetUserLocalizer returns the localizer for the language the user has chosen,
+// or for the chain language if they have not chosen any.
+func (reporter *Reporter) GetUserLocalizer(userID string) *i18n.Localizer {
+	if language := reporter.Manager.GetNotifierLanguage(reporter.Name(), userID); language != "" {
+		return i18n.GetLocalizer(language)
+	}
+
+	return i18n.GetLocalizer(reporter.Config.Language)
+}
+
+// TranslateReply translates the reply into the user language, escaping the message
+// and its arguments, except for the ones already serialized as HTML, like links.
+func (reporter *Reporter) TranslateReply(userID string, message string, args ...any) string {
+	return string(templatesPkg.TranslateHTML(reporter.GetUserLocalizer(userID), message, args...))
+}
+
+func SerializeCode(value string) template.HTML {
+	return template.HTML("<code>" + html.EscapeString(value) + "</code>")
 }
 
 func (reporter *Reporter) Name() constants.ReporterName {
 	return constants.TelegramReporterName
 }
 
-func (reporter *Reporter) BotSend(msg string) error {
-	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
-
-	for _, message := range messages {
-		if _, err := reporter.TelegramBot.Send(
-			&tele.User{
-				ID: reporter.Chat,
-			},
-			message,
-			tele.ModeHTML,
-			tele.NoPreview,
-		); err != nil {
-			reporter.Logger.Error().Err(err).Msg("Could not send Telegram message")
-			return err
-		}
-	}
-	return nil
+func (reporter *Reporter) BotSend(chat int64, topic int, msg string, markup *tele.ReplyMarkup) error {
+	return reporter.Bot.BotSend(chat, topic, msg, markup)
 }
 
 func (reporter *Reporter) BotReply(c tele.Context, msg string) error {
-	messages := utils.SplitStringIntoChunks(msg, MaxMessageSize)
-
-	for _, message := range messages {
-		if err := c.Reply(message, tele.ModeHTML, tele.NoPreview); err != nil {
-			reporter.Logger.Error().Err(err).Msg("Could not send Telegram message")
-			return err
-		}
-	}
-	return nil
+	return reporter.Bot.BotReply(c, msg)
 }
 
 func (reporter *Reporter) SerializeDate(date time.Time) string {
-	return date.Format(time.RFC822)
+	return reporter.TemplatesManager.SerializeDate(date)
 }
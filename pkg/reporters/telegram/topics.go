@@ -0,0 +1,92 @@
+package telegram
+
+import (
+	"encoding/json"
+
+	tele "gopkg.in/telebot.v3"
+)
+
+// Forum topics are not supported by telebot yet, so these are done with raw API calls.
+
+type forumTopic struct {
+	MessageThreadID int    `json:"message_thread_id"`
+	Name            string `json:"name"`
+}
+
+func (b *Bot) sendToTopic(chat int64, topic int, msg string, markup *tele.ReplyMarkup) (*tele.Message, error) {
+	params := map[string]interface{}{
+		"chat_id":                  chat,
+		"message_thread_id":        topic,
+		"text":                     msg,
+		"parse_mode":               tele.ModeHTML,
+		"disable_web_page_preview": true,
+	}
+
+	if markup != nil {
+		params["reply_markup"] = serializeInlineKeyboard(markup)
+	}
+
+	data, err := b.TelegramBot.Raw("sendMessage", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Result *tele.Message `json:"result"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
+// CreateTopic creates a forum topic in the chat and returns its ID.
+func (b *Bot) CreateTopic(chat int64, name string) (int, error) {
+	data, err := b.TelegramBot.Raw("createForumTopic", map[string]interface{}{
+		"chat_id": chat,
+		"name":    name,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		Result forumTopic `json:"result"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return 0, err
+	}
+
+	return response.Result.MessageThreadID, nil
+}
+
+func (b *Bot) CloseTopic(chat int64, topic int) error {
+	_, err := b.TelegramBot.Raw("closeForumTopic", map[string]interface{}{
+		"chat_id":           chat,
+		"message_thread_id": topic,
+	})
+	return err
+}
+
+// serializeInlineKeyboard converts the inline keyboard the same way telebot does,
+// prefixing the callback data with the button's unique name for routing.
+func serializeInlineKeyboard(markup *tele.ReplyMarkup) map[string]interface{} {
+	keyboard := make([][]map[string]string, len(markup.InlineKeyboard))
+
+	for index, row := range markup.InlineKeyboard {
+		for _, button := range row {
+			data := button.Data
+			if button.Unique != "" {
+				data = "\f" + button.Unique + "|" + button.Data
+			}
+
+			keyboard[index] = append(keyboard[index], map[string]string{
+				"text":          button.Text,
+				"callback_data": data,
+			})
+		}
+	}
+
+	return map[string]interface{}{"inline_keyboard": keyboard}
+}
@@ -3,8 +3,8 @@ package telegram
 import (
 	"fmt"
 	"main/pkg/config"
+	"main/pkg/i18n"
 	"main/pkg/types"
-	"main/pkg/utils"
 	"time"
 )
 
@@ -42,6 +42,7 @@ type paramsRender struct {
 	BlockTime       time.Duration
 	MaxTimeToJail   time.Duration
 	ValidatorsCount int
+	Localizer       *i18n.Localizer
 }
 
 func (r paramsRender) FormatMinSignedPerWindow() string {
@@ -53,7 +54,7 @@ func (r paramsRender) FormatAvgBlockTime() string {
 }
 
 func (r paramsRender) FormatTimeToJail() string {
-	return utils.FormatDuration(r.MaxTimeToJail)
+	return r.Localizer.FormatDuration(r.MaxTimeToJail)
 }
 
 func (r paramsRender) FormatGroupPercent(group *config.MissedBlocksGroup) string {
@@ -66,10 +67,10 @@ func (r paramsRender) FormatGroupPercent(group *config.MissedBlocksGroup) string
 
 func (r paramsRender) FormatSnapshotInterval() string {
 	if r.Config.SnapshotsInterval == 1 {
-		return "every block"
+		return r.Localizer.Translate("every block")
 	}
 
-	return fmt.Sprintf("every %d blocks", r.Config.SnapshotsInterval)
+	return r.Localizer.Translate("every %d blocks", r.Config.SnapshotsInterval)
 }
 
 type statusEntry struct {
@@ -92,3 +93,19 @@ func (s statusRender) FormatNotSignedPercent(entry statusEntry) string {
 func (s statusRender) FormatVotingPower(entry statusEntry) string {
 	return fmt.Sprintf("%.2f%% VP", entry.Validator.VotingPowerPercent*100)
 }
+
+type helpRender struct {
+	Version string
+	Chains  []string
+}
+
+type chartRender struct {
+	Config             *config.ChainConfig
+	Link               types.Link
+	BlocksCount        int
+	Signed             int64
+	Missed             int64
+	NotActive          int64
+	MissedBlocksToJail int64
+	TimeToJail         time.Duration
+}
@@ -1,8 +1,6 @@
 package telegram
 
 import (
-	"fmt"
-	"html"
 	"main/pkg/constants"
 	"strconv"
 	"strings"
@@ -18,36 +16,40 @@ func (reporter *Reporter) HandleUnsubscribe(c tele.Context) error {
 
 	reporter.MetricsManager.LogReporterQuery(reporter.Config.Name, constants.TelegramReporterName, "unsubscribe")
 
+	userID := strconv.FormatInt(c.Sender().ID, 10)
+
 	args := strings.Split(c.Text(), " ")
 	if len(args) < 2 {
-		return reporter.BotReply(c, html.EscapeString(fmt.Sprintf(
-			"Usage: %s <validator address>",
-			args[0],
-		)))
+		return reporter.BotReply(c, reporter.TranslateReply(userID, "Usage: %s <validator address>", args[0]))
 	}
 
 	address := args[1]
 
 	validator, found := reporter.Manager.GetValidator(address)
 	if !found {
-		return reporter.BotReply(c, fmt.Sprintf(
-			"Could not find a validator with address <code>%s</code>",
-			address,
+		return reporter.BotReply(c, reporter.TranslateReply(
+			userID,
+			"Could not find a validator with address %s on %s",
+			SerializeCode(address),
+			reporter.Config.GetName(),
 		))
 	}
 
-	removed := reporter.Manager.RemoveNotifier(address, reporter.Name(), strconv.FormatInt(c.Sender().ID, 10))
-
-	if !removed {
-		return reporter.BotReply(c, "You are not subscribed to this validator's notifications")
-	}
-
 	validatorLink := reporter.Config.ExplorerConfig.GetValidatorLink(validator)
 	validatorLinkSerialized := reporter.TemplatesManager.SerializeLink(validatorLink)
 
-	return reporter.BotReply(c, fmt.Sprintf(
+	// the reply is translated before the notifier is removed, as its language is lost with it
+	notSubscribed := reporter.TranslateReply(userID, "You are not subscribed to this validator's notifications")
+	unsubscribed := reporter.TranslateReply(
+		userID,
 		"Unsubscribed from validator's notifications on %s: %s",
 		reporter.Config.GetName(),
 		validatorLinkSerialized,
-	))
+	)
+
+	if removed := reporter.Manager.RemoveNotifier(address, reporter.Name(), userID); !removed {
+		return reporter.BotReply(c, notSubscribed)
+	}
+
+	return reporter.BotReply(c, unsubscribed)
 }
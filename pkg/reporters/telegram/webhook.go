@@ -0,0 +1,145 @@
+package telegram
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	configPkg "main/pkg/config"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	tele "gopkg.in/telebot.v3"
+)
+
+// WebhookServer receives the updates of all Telegram bots over a single HTTP server,
+// each of them on its own path, as an alternative to long polling.
+type WebhookServer struct {
+	Config configPkg.TelegramWebhookConfig
+	Logger zerolog.Logger
+
+	handler *http.ServeMux
+	pollers map[string]*webhookPoller
+	mutex   sync.Mutex
+}
+
+// webhookPoller registers the bot's webhook and passes the updates received
+// by the webhook server to the bot.
+type webhookPoller struct {
+	webhook *tele.Webhook
+	updates chan tele.Update
+	logger  zerolog.Logger
+}
+
+func NewWebhookServer(logger zerolog.Logger, config configPkg.TelegramWebhookConfig) *WebhookServer {
+	return &WebhookServer{
+		Config:  config,
+		Logger:  logger.With().Str("component", "telegram_webhook").Logger(),
+		handler: http.NewServeMux(),
+		pollers: make(map[string]*webhookPoller),
+	}
+}
+
+func (s *WebhookServer) Enabled() bool {
+	return s != nil && s.Config.Enabled()
+}
+
+func (s *WebhookServer) Start() {
+	if !s.Enabled() {
+		s.Logger.Info().Msg("Telegram webhook not enabled")
+		return
+	}
+
+	server := &http.Server{
+		Addr:              s.Config.ListenAddr,
+		Handler:           s.handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.Logger.Info().Str("addr", s.Config.ListenAddr).Msg("Telegram webhook handler listening")
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.Logger.Error().
+			Err(err).
+			Str("addr", s.Config.ListenAddr).
+			Msg("Cannot start Telegram webhook handler")
+	}
+}
+
+// GetPoller returns the poller for the bot with the given token. The bot ID from the token
+// is used as its path, so each bot has its own endpoint no matter how many chains it serves.
+func (s *WebhookServer) GetPoller(token string) tele.Poller {
+	botID, _, _ := strings.Cut(token, ":")
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if poller, ok := s.pollers[botID]; ok {
+		s.Logger.Warn().Str("bot", botID).Msg("Telegram bot is used more than once, define it in [[telegram-bots]] instead")
+		return poller
+	}
+
+	poller := &webhookPoller{
+		webhook: &tele.Webhook{
+			SecretToken: s.Config.SecretToken,
+			Endpoint:    &tele.WebhookEndpoint{PublicURL: s.Config.GetBotURL(botID)},
+		},
+		updates: make(chan tele.Update, 100),
+		logger:  s.Logger.With().Str("bot", botID).Logger(),
+	}
+
+	path := s.Config.GetBotPath(botID)
+	s.handler.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		s.HandleUpdate(w, r, poller)
+	})
+
+	s.pollers[botID] = poller
+	s.Logger.Info().Str("bot", botID).Str("path", path).Msg("Registered Telegram webhook")
+
+	return poller
+}
+
+func (s *WebhookServer) HandleUpdate(w http.ResponseWriter, r *http.Request, poller *webhookPoller) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// the config requires the secret token, but an empty one should never let a request without the header in
+	secretToken := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+	if s.Config.SecretToken == "" || secretToken == "" || subtle.ConstantTimeCompare(
+		[]byte(secretToken),
+		[]byte(s.Config.SecretToken),
+	) != 1 {
+		poller.logger.Warn().Msg("Got Telegram webhook request with invalid secret token")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var update tele.Update
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		poller.logger.Warn().Err(err).Msg("Could not decode Telegram update")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	poller.updates <- update
+	w.WriteHeader(http.StatusOK)
+}
+
+func (p *webhookPoller) Poll(b *tele.Bot, dest chan tele.Update, stop chan struct{}) {
+	if err := b.SetWebhook(p.webhook); err != nil {
+		p.logger.Error().Err(err).Msg("Could not set Telegram webhook")
+	}
+
+	for {
+		select {
+		case update := <-p.updates:
+			dest <- update
+		case <-stop:
+			return
+		}
+	}
+}
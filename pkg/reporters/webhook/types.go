@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"main/pkg/constants"
+	"time"
+)
+
+type ValidatorPayload struct {
+	Moniker          string `json:"moniker"`
+	OperatorAddress  string `json:"operator_address"`
+	ConsensusAddress string `json:"consensus_address"`
+	Link             string `json:"link,omitempty"`
+}
+
+type MissedBlocksGroupPayload struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+type EventPayload struct {
+	EventName               constants.EventName       `json:"event_name"`
+	Height                  int64                     `json:"height"`
+	Validator               ValidatorPayload          `json:"validator"`
+	MissedBlocksBefore      *int64                    `json:"missed_blocks_before,omitempty"`
+	MissedBlocksAfter       *int64                    `json:"missed_blocks_after,omitempty"`
+	MissedBlocksGroupBefore *MissedBlocksGroupPayload `json:"missed_blocks_group_before,omitempty"`
+	MissedBlocksGroupAfter  *MissedBlocksGroupPayload `json:"missed_blocks_group_after,omitempty"`
+	TimeToJail              *float64                  `json:"time_to_jail_seconds,omitempty"`
+}
+
+type ReportPayload struct {
+	Chain  string         `json:"chain"`
+	Height int64          `json:"height"`
+	Time   time.Time      `json:"time"`
+	Events []EventPayload `json:"events"`
+}
@@ -0,0 +1,198 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	httpPkg "main/pkg/http"
+	"main/pkg/metrics"
+	statePkg "main/pkg/state"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	SignatureHeader = "X-Missed-Blocks-Checker-Signature"
+	TimestampHeader = "X-Missed-Blocks-Checker-Timestamp"
+
+	// SignatureTolerance is how old a request's timestamp can be for the receiver
+	// to still accept it, so a captured request cannot be replayed later.
+	SignatureTolerance = 5 * time.Minute
+)
+
+type Reporter struct {
+	URLs   []string
+	Secret string
+
+	Clients        []*httpPkg.Client
+	Logger         zerolog.Logger
+	Config         *config.ChainConfig
+	Manager        *statePkg.Manager
+	MetricsManager *metrics.Manager
+}
+
+func NewReporter(
+	chainConfig *config.ChainConfig,
+	logger zerolog.Logger,
+	manager *statePkg.Manager,
+	metricsManager *metrics.Manager,
+) *Reporter {
+	return &Reporter{
+		URLs:           chainConfig.WebhookConfig.URLs,
+		Secret:         chainConfig.WebhookConfig.Secret,
+		Config:         chainConfig,
+		Logger:         logger.With().Str("component", "webhook_reporter").Logger(),
+		Manager:        manager,
+		MetricsManager: metricsManager,
+	}
+}
+
+func (reporter *Reporter) Init() {
+	if !reporter.Enabled() {
+		reporter.Logger.Debug().Msg("Webhook URLs not set, not creating webhook reporter")
+		return
+	}
+
+	reporter.Clients = utils.Map(reporter.URLs, func(url string) *httpPkg.Client {
+		return httpPkg.NewClient(reporter.Logger, reporter.MetricsManager, url, reporter.Config.Name)
+	})
+
+	if reporter.Secret == "" {
+		reporter.Logger.Warn().Msg("Webhook secret is not set, requests will not be signed")
+	}
+}
+
+func (reporter *Reporter) Enabled() bool {
+	return len(reporter.URLs) > 0
+}
+
+func (reporter *Reporter) Name() constants.ReporterName {
+	return constants.WebhookReporterName
+}
+
+func (reporter *Reporter) SerializeEvent(event types.ReportEvent) types.RenderEventItem {
+	validator := event.GetValidator()
+
+	eventToRender := types.RenderEventItem{
+		Event:         event,
+		ValidatorLink: reporter.Config.ExplorerConfig.GetValidatorLink(validator),
+	}
+
+	if eventChanged, ok := event.(events.ValidatorGroupChanged); ok && eventChanged.IsIncreasing() {
+		eventToRender.TimeToJail = reporter.Manager.GetTimeTillJail(eventChanged.MissedBlocksAfter)
+	}
+
+	return eventToRender
+}
+
+func (reporter *Reporter) SerializeEventPayload(height int64, event types.RenderEventItem) EventPayload {
+	validator := event.Event.GetValidator()
+
+	payload := EventPayload{
+		EventName: event.Event.Type(),
+		Height:    height,
+		Validator: ValidatorPayload{
+			Moniker:          validator.Moniker,
+			OperatorAddress:  validator.OperatorAddress,
+			ConsensusAddress: validator.ConsensusAddressValcons,
+			Link:             event.ValidatorLink.Href,
+		},
+	}
+
+	if eventChanged, ok := event.Event.(events.ValidatorGroupChanged); ok {
+		payload.MissedBlocksBefore = &eventChanged.MissedBlocksBefore
+		payload.MissedBlocksAfter = &eventChanged.MissedBlocksAfter
+		payload.MissedBlocksGroupBefore = &MissedBlocksGroupPayload{
+			Start: eventChanged.MissedBlocksGroupBefore.Start,
+			End:   eventChanged.MissedBlocksGroupBefore.End,
+		}
+		payload.MissedBlocksGroupAfter = &MissedBlocksGroupPayload{
+			Start: eventChanged.MissedBlocksGroupAfter.Start,
+			End:   eventChanged.MissedBlocksGroupAfter.End,
+		}
+
+		if eventChanged.IsIncreasing() {
+			timeToJail := event.TimeToJail.Seconds()
+			payload.TimeToJail = &timeToJail
+		}
+	}
+
+	return payload
+}
+
+func (reporter *Reporter) Send(report *types.Report) error {
+	reporter.MetricsManager.LogReport(reporter.Config.Name, report)
+
+	// the payload is rendered once and stored for each URL, so a retry posts the same body
+	var body []byte
+	render := func() (string, error) {
+		if body != nil {
+			return string(body), nil
+		}
+
+		payload := ReportPayload{
+			Chain:  reporter.Config.Name,
+			Height: report.Height,
+			Time:   time.Now(),
+			Events: make([]EventPayload, len(report.Events)),
+		}
+
+		for index, event := range report.Events {
+			payload.Events[index] = reporter.SerializeEventPayload(report.Height, reporter.SerializeEvent(event))
+		}
+
+		rendered, err := json.Marshal(payload)
+		if err != nil {
+			reporter.Logger.Error().Err(err).Msg("Could not marshal webhook payload")
+			return "", err
+		}
+
+		body = rendered
+		return string(body), nil
+	}
+
+	var errs []error
+
+	for _, client := range reporter.Clients {
+		if err := report.Deliver("url:"+client.Host, render, func(content string) error {
+			headers := map[string]string{
+				"Content-Type": "application/json",
+			}
+
+			// the timestamp is taken on each attempt, so a retried request is not rejected as stale
+			if reporter.Secret != "" {
+				timestamp := time.Now().Unix()
+				headers[TimestampHeader] = strconv.FormatInt(timestamp, 10)
+				headers[SignatureHeader] = "sha256=" + Sign(timestamp, []byte(content), reporter.Secret)
+			}
+
+			return client.Post("", constants.QueryTypeWebhook, []byte(content), headers)
+		}); err != nil {
+			reporter.Logger.Error().
+				Err(err).
+				Str("url", client.Host).
+				Msg("Could not send webhook")
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" with the secret as a key,
+// so the signature also covers the time the request was sent at.
+func Sign(timestamp int64, body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
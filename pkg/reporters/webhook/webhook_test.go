@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	httpPkg "main/pkg/http"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/metrics"
+	"main/pkg/types"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"chain":"cosmos"}`)
+	signature := Sign(1700000000, body, "secret")
+
+	require.Equal(t, "d5504bd70cfca4523aa35a5c47209d40faa65b51528adda308b1021c4d33b6f2", signature)
+	require.NotEqual(t, signature, Sign(1700000001, body, "secret"))
+	require.NotEqual(t, signature, Sign(1700000000, body, "other"))
+}
+
+func TestSerializeEventPayloadGroupChanged(t *testing.T) {
+	t.Parallel()
+
+	reporter := &Reporter{}
+	payload := reporter.SerializeEventPayload(100, types.RenderEventItem{
+		Event: events.ValidatorGroupChanged{
+			Validator: &types.Validator{
+				Moniker:                 "validator",
+				OperatorAddress:         "cosmosvaloper1xxx",
+				ConsensusAddressValcons: "cosmosvalcons1xxx",
+			},
+			MissedBlocksBefore:      90,
+			MissedBlocksAfter:       110,
+			MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{Start: 50, End: 99},
+			MissedBlocksGroupAfter:  &configPkg.MissedBlocksGroup{Start: 100, End: 499},
+		},
+		ValidatorLink: types.Link{Href: "https://mintscan.io/cosmos/validators/cosmosvaloper1xxx"},
+		TimeToJail:    time.Minute,
+	})
+
+	payloadBytes, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"event_name": "ValidatorGroupChanged",
+		"height": 100,
+		"validator": {
+			"moniker": "validator",
+			"operator_address": "cosmosvaloper1xxx",
+			"consensus_address": "cosmosvalcons1xxx",
+			"link": "https://mintscan.io/cosmos/validators/cosmosvaloper1xxx"
+		},
+		"missed_blocks_before": 90,
+		"missed_blocks_after": 110,
+		"missed_blocks_group_before": {"start": 50, "end": 99},
+		"missed_blocks_group_after": {"start": 100, "end": 499},
+		"time_to_jail_seconds": 60
+	}`, string(payloadBytes))
+}
+
+func TestSerializeEventPayloadJailed(t *testing.T) {
+	t.Parallel()
+
+	reporter := &Reporter{}
+	payload := reporter.SerializeEventPayload(100, types.RenderEventItem{
+		Event: events.ValidatorJailed{
+			Validator: &types.Validator{Moniker: "validator", OperatorAddress: "cosmosvaloper1xxx"},
+		},
+	})
+
+	payloadBytes, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"event_name": "ValidatorJailed",
+		"height": 100,
+		"validator": {
+			"moniker": "validator",
+			"operator_address": "cosmosvaloper1xxx",
+			"consensus_address": ""
+		}
+	}`, string(payloadBytes))
+}
+
+func TestSendSigned(t *testing.T) {
+	t.Parallel()
+
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	logger := loggerPkg.GetNopLogger()
+	metricsManager := metrics.NewManager(*logger, configPkg.MetricsConfig{Enabled: null.BoolFrom(false)})
+	reporter := &Reporter{
+		URLs:           []string{server.URL},
+		Secret:         "secret",
+		Clients:        []*httpPkg.Client{httpPkg.NewClient(*logger, metricsManager, server.URL, "chain")},
+		Logger:         *logger,
+		Config:         &configPkg.ChainConfig{Name: "chain", ExplorerConfig: configPkg.ExplorerConfig{MintscanPrefix: "cosmos"}},
+		MetricsManager: metricsManager,
+	}
+
+	err := reporter.Send(&types.Report{
+		Height: 100,
+		Events: []types.ReportEvent{events.ValidatorJailed{
+			Validator: &types.Validator{Moniker: "validator", OperatorAddress: "cosmosvaloper1xxx"},
+		}},
+	})
+	require.NoError(t, err)
+
+	request := <-requests
+	body := <-bodies
+
+	timestamp, err := strconv.ParseInt(request.Header.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.Unix(timestamp, 0), SignatureTolerance)
+	require.Equal(t, "sha256="+Sign(timestamp, body, "secret"), request.Header.Get(SignatureHeader))
+
+	var payload ReportPayload
+	require.NoError(t, json.Unmarshal(body, &payload))
+	require.Equal(t, "chain", payload.Chain)
+	require.Equal(t, int64(100), payload.Height)
+	require.Len(t, payload.Events, 1)
+	require.Equal(t, constants.EventValidatorJailed, payload.Events[0].EventName)
+	require.Equal(t, "https://mintscan.io/cosmos/validators/cosmosvaloper1xxx", payload.Events[0].Validator.Link)
+}
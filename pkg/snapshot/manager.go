@@ -55,7 +55,13 @@ func (m *Manager) GetNewerHeight() int64 {
 }
 
 func (m *Manager) GetReport() (*types.Report, error) {
-	return m.newerSnapshot.Snapshot.GetReport(m.olderSnapshot.Snapshot, m.config)
+	report, err := m.newerSnapshot.Snapshot.GetReport(m.olderSnapshot.Snapshot, m.config)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Height = m.newerSnapshot.Height
+	return report, nil
 }
 
 func (m *Manager) GetNewerSnapshot() (*Snapshot, bool) {
@@ -55,6 +55,7 @@ func TestManagerCommitNewSnapshot(t *testing.T) {
 	report, err := manager.GetReport()
 	require.NoError(t, err, "Error should not be presented!")
 	assert.True(t, report.Empty(), "Report should be empty!")
+	assert.Equal(t, int64(20), report.Height, "Height mismatch!")
 }
 
 func TestManagerGetNewerSnapshot(t *testing.T) {
@@ -74,6 +74,30 @@ func (b *Blocks) GetBlock(height int64) (*types.Block, bool) {
 	return block, ok
 }
 
+func (b *Blocks) GetLastHeight() int64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.lastHeight
+}
+
+// GetLatestBlocks returns the last height and the stored blocks among the expected
+// latest ones, both read under the same lock so they are consistent with each other.
+func (b *Blocks) GetLatestBlocks(expected int64) (int64, types.BlocksMap) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	blocks := make(types.BlocksMap)
+
+	for height := b.lastHeight; height > b.lastHeight-expected; height-- {
+		if block, ok := b.blocks[height]; ok {
+			blocks[height] = block
+		}
+	}
+
+	return b.lastHeight, blocks
+}
+
 func (b *Blocks) GetLatestBlock() *types.Block {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
@@ -97,10 +121,13 @@ func (b *Blocks) GetEarliestBlock() *types.Block {
 }
 
 func (b *Blocks) GetCountSinceLatest(expected int64) int64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
 	var expectedCount int64 = 0
 
 	for height := b.lastHeight; height > b.lastHeight-expected; height-- {
-		if b.HasBlockAtHeight(height) {
+		if _, ok := b.blocks[height]; ok {
 			expectedCount++
 		}
 	}
@@ -109,12 +136,15 @@ func (b *Blocks) GetCountSinceLatest(expected int64) int64 {
 }
 
 func (b *Blocks) GetMissingSinceLatest(expected int64, firstBlock int64) []int64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
 	var missed []int64
 
 	earliestBlock := utils.MaxInt64(b.lastHeight-expected, firstBlock-1)
 
 	for height := b.lastHeight; height > earliestBlock; height-- {
-		if !b.HasBlockAtHeight(height) {
+		if _, ok := b.blocks[height]; !ok {
 			missed = append(missed, height)
 		}
 	}
@@ -141,3 +141,19 @@ func TestBlocksTrimBlocksBefore(t *testing.T) {
 	assert.True(t, state.HasBlockAtHeight(4), "Blocks mismatch!")
 	assert.True(t, state.HasBlockAtHeight(5), "Blocks mismatch!")
 }
+
+func TestBlocksGetLatestBlocks(t *testing.T) {
+	t.Parallel()
+
+	blocks := NewBlocks()
+	blocks.AddBlock(&types.Block{Height: 1})
+	blocks.AddBlock(&types.Block{Height: 3})
+	blocks.AddBlock(&types.Block{Height: 5})
+
+	lastHeight, latestBlocks := blocks.GetLatestBlocks(3)
+	assert.Equal(t, int64(5), lastHeight, "Height mismatch!")
+	assert.Len(t, latestBlocks, 2, "Blocks count mismatch!")
+	assert.Contains(t, latestBlocks, int64(3), "Block should be returned!")
+	assert.Contains(t, latestBlocks, int64(5), "Block should be returned!")
+	assert.NotContains(t, latestBlocks, int64(1), "Block should not be returned!")
+}
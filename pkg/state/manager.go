@@ -1,9 +1,11 @@
 package state
 
 import (
+	"fmt"
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
 	databasePkg "main/pkg/database"
+	"main/pkg/events"
 	"main/pkg/metrics"
 	snapshotPkg "main/pkg/snapshot"
 	"main/pkg/types"
@@ -68,6 +70,36 @@ func (m *Manager) Init() {
 		Float64("duration", time.Since(notifiersStart).Seconds()).
 		Msg("Loaded notifiers from database")
 
+	maintenanceWindows, err := m.database.GetAllMaintenanceWindows(m.config.Name)
+	if err != nil {
+		m.logger.Fatal().Err(err).Msg("Could not get maintenance windows from the database")
+	}
+
+	m.state.SetMaintenanceWindows(maintenanceWindows)
+	m.logger.Info().
+		Int("len", len(maintenanceWindows)).
+		Msg("Loaded maintenance windows from database")
+
+	incidents, err := m.database.GetOpenIncidents(m.config.Name)
+	if err != nil {
+		m.logger.Fatal().Err(err).Msg("Could not get incidents from the database")
+	}
+
+	m.state.SetIncidents(incidents)
+	m.logger.Info().
+		Int("len", len(incidents)).
+		Msg("Loaded open incidents from database")
+
+	incidentMessages, err := m.database.GetAllIncidentMessages(m.config.Name)
+	if err != nil {
+		m.logger.Fatal().Err(err).Msg("Could not get incident messages from the database")
+	}
+
+	m.state.SetIncidentMessages(incidentMessages)
+	m.logger.Info().
+		Int("len", len(incidentMessages)).
+		Msg("Loaded incident messages from database")
+
 	snapshotStart := time.Now()
 
 	snapshot, err := m.database.GetLastSnapshot(m.config.Name)
@@ -173,12 +205,37 @@ func (m *Manager) AddNotifier(
 	reporter constants.ReporterName,
 	userID string,
 	userName string,
+	filters types.NotifierFilters,
 ) bool {
-	if added := m.state.AddNotifier(operatorAddress, reporter, userID, userName); !added {
+	if added := m.state.AddNotifier(operatorAddress, reporter, userID, userName, filters); !added {
 		return false
 	}
 
-	err := m.database.InsertNotifier(m.config.Name, operatorAddress, reporter, userID, userName)
+	err := m.database.InsertNotifier(
+		m.config.Name,
+		operatorAddress,
+		reporter,
+		userID,
+		userName,
+		m.state.GetNotifierDeliveryMode(reporter, userID),
+		m.state.GetNotifierLanguage(reporter, userID),
+		m.state.GetNotifierQuietHours(reporter, userID),
+		filters,
+	)
+	return err == nil
+}
+
+func (m *Manager) SetNotifierFilters(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	filters types.NotifierFilters,
+) bool {
+	if found := m.state.SetNotifierFilters(operatorAddress, reporter, userID, filters); !found {
+		return false
+	}
+
+	err := m.database.UpdateNotifierFilters(m.config.Name, operatorAddress, reporter, userID, filters)
 	return err == nil
 }
 
@@ -195,6 +252,123 @@ func (m *Manager) RemoveNotifier(
 	return err == nil
 }
 
+func (m *Manager) GetNotifierDeliveryMode(
+	reporter constants.ReporterName,
+	userID string,
+) constants.DeliveryMode {
+	return m.state.GetNotifierDeliveryMode(reporter, userID)
+}
+
+func (m *Manager) SetNotifierDeliveryMode(
+	reporter constants.ReporterName,
+	userID string,
+	deliveryMode constants.DeliveryMode,
+) bool {
+	if found := m.state.SetNotifierDeliveryMode(reporter, userID, deliveryMode); !found {
+		return false
+	}
+
+	err := m.database.UpdateNotifiersDeliveryMode(m.config.Name, reporter, userID, deliveryMode)
+	return err == nil
+}
+
+// GetNotifierLanguage returns the language the user has chosen, or an empty string
+// if they have not, so the chain language is used.
+func (m *Manager) GetNotifierLanguage(
+	reporter constants.ReporterName,
+	userID string,
+) constants.Language {
+	return m.state.GetNotifierLanguage(reporter, userID)
+}
+
+func (m *Manager) SetNotifierLanguage(
+	reporter constants.ReporterName,
+	userID string,
+	language constants.Language,
+) bool {
+	if found := m.state.SetNotifierLanguage(reporter, userID, language); !found {
+		return false
+	}
+
+	err := m.database.UpdateNotifiersLanguage(m.config.Name, reporter, userID, language)
+	return err == nil
+}
+
+func (m *Manager) GetNotifierQuietHours(
+	reporter constants.ReporterName,
+	userID string,
+) types.QuietHours {
+	return m.state.GetNotifierQuietHours(reporter, userID)
+}
+
+func (m *Manager) SetNotifierQuietHours(
+	reporter constants.ReporterName,
+	userID string,
+	quietHours types.QuietHours,
+) bool {
+	if found := m.state.SetNotifierQuietHours(reporter, userID, quietHours); !found {
+		return false
+	}
+
+	err := m.database.UpdateNotifiersQuietHours(m.config.Name, reporter, userID, quietHours)
+	return err == nil
+}
+
+// MuteNotifier mutes the user's subscription to a validator, or to all
+// validators if the operator address is empty, until the given time.
+func (m *Manager) MuteNotifier(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	mutedUntil time.Time,
+) bool {
+	if found := m.state.SetNotifierMutedUntil(operatorAddress, reporter, userID, mutedUntil); !found {
+		return false
+	}
+
+	err := m.database.UpdateNotifiersMutedUntil(m.config.Name, operatorAddress, reporter, userID, mutedUntil)
+	return err == nil
+}
+
+func (m *Manager) UnmuteNotifier(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+) bool {
+	return m.MuteNotifier(operatorAddress, reporter, userID, time.Time{})
+}
+
+func (m *Manager) GetNotifiersToMention(
+	event types.ReportEvent,
+	reporter constants.ReporterName,
+) []*types.Notifier {
+	// whoever acknowledged the incident is handling it, no need to ping others
+	if incident, found := m.state.GetIncident(event.GetValidator().OperatorAddress); found && incident.IsAcked() {
+		return []*types.Notifier{}
+	}
+
+	now := time.Now()
+	notifiers := m.state.GetNotifiersForReporter(event.GetValidator().OperatorAddress, reporter)
+
+	return utils.Filter(notifiers, func(notifier *types.Notifier) bool {
+		return notifier.IsMentionedInChannel() &&
+			!notifier.IsSilenced(now) &&
+			notifier.Filters.Matches(event, m.config.BlocksWindow)
+	})
+}
+
+func (m *Manager) GetDirectMessages(
+	report *types.Report,
+	reporter constants.ReporterName,
+) []*types.DirectMessage {
+	reportEvents := utils.Filter(report.Events, func(event types.ReportEvent) bool {
+		incident, found := m.state.GetIncident(event.GetValidator().OperatorAddress)
+		return !found || !incident.IsAcked()
+	})
+
+	return m.state.GetDirectMessages(reportEvents, reporter, m.config.BlocksWindow)
+}
+
 func (m *Manager) GetNotifiersForReporter(
 	operatorAddress string,
 	reporter constants.ReporterName,
@@ -209,6 +383,332 @@ func (m *Manager) GetValidatorsForNotifier(
 	return m.state.GetValidatorsForNotifier(reporter, notifier)
 }
 
+func (m *Manager) GetMaintenanceWindows() []*types.MaintenanceWindow {
+	return m.state.GetMaintenanceWindows()
+}
+
+func (m *Manager) SetMaintenanceWindow(window *types.MaintenanceWindow) error {
+	if err := m.database.UpsertMaintenanceWindow(m.config.Name, window); err != nil {
+		return err
+	}
+
+	m.state.SetMaintenanceWindow(window)
+	return nil
+}
+
+func (m *Manager) RemoveMaintenanceWindow(operatorAddress string) bool {
+	if removed := m.state.RemoveMaintenanceWindow(operatorAddress); !removed {
+		return false
+	}
+
+	err := m.database.RemoveMaintenanceWindow(m.config.Name, operatorAddress)
+	return err == nil
+}
+
+// ApplyMaintenanceWindows holds back the missed blocks group changes of validators
+// under maintenance, and adds a summary for each maintenance window that is over.
+func (m *Manager) ApplyMaintenanceWindows(report *types.Report, now time.Time) *types.Report {
+	newReport := &types.Report{Height: report.Height}
+
+	for _, event := range report.Events {
+		window, found := m.state.GetMaintenanceWindow(event.GetValidator().OperatorAddress)
+		if found && event.Type() == constants.EventValidatorGroupChanged && window.IsActive(now, report.Height) {
+			m.logger.Info().
+				Str("valoper", window.OperatorAddress).
+				Str("window", window.String()).
+				Msg("Validator is under maintenance, not reporting missed blocks group change")
+			continue
+		}
+
+		newReport.Events = append(newReport.Events, event)
+	}
+
+	snapshot, found := m.snapshotManager.GetNewerSnapshot()
+	if !found {
+		return newReport
+	}
+
+	for _, window := range m.state.GetMaintenanceWindows() {
+		if !window.IsOver(now, report.Height) {
+			continue
+		}
+
+		m.RemoveMaintenanceWindow(window.OperatorAddress)
+
+		entry, found := snapshot.Entries[window.OperatorAddress]
+		if !found {
+			m.logger.Warn().
+				Str("valoper", window.OperatorAddress).
+				Msg("Maintenance window is over, but the validator is not found")
+			continue
+		}
+
+		missedBlocks := entry.SignatureInfo.GetNotSigned()
+		group, groupIndex, err := m.config.MissedBlocksGroups.GetGroup(missedBlocks)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("Could not get missed blocks group")
+			continue
+		}
+
+		isTombstoned := entry.Validator.SigningInfo != nil && entry.Validator.SigningInfo.Tombstoned
+
+		newReport.Events = append(newReport.Events, events.ValidatorMaintenanceOver{
+			Validator:         entry.Validator,
+			Window:            window,
+			MissedBlocks:      missedBlocks,
+			MissedBlocksGroup: group,
+			IsActive:          entry.IsActive,
+			Recovered:         entry.IsActive && !entry.Validator.Jailed && !isTombstoned && groupIndex == 0,
+		})
+	}
+
+	return newReport
+}
+
+func (m *Manager) GetIncident(operatorAddress string) (*types.Incident, bool) {
+	return m.state.GetIncident(operatorAddress)
+}
+
+// AckIncident records who is handling the incident. If it is already acknowledged,
+// the incident is returned as is, so the caller can see who acknowledged it.
+func (m *Manager) AckIncident(incidentID int64, ackedBy string) (*types.Incident, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	incident, found := m.state.GetIncidentByID(incidentID)
+	if !found {
+		return nil, fmt.Errorf("incident is not found or is already resolved")
+	}
+
+	if incident.IsAcked() {
+		return incident, nil
+	}
+
+	ackedIncident := *incident
+	ackedIncident.AckedBy = ackedBy
+	ackedIncident.AckedAt = time.Now()
+
+	if err := m.database.UpdateIncidentAck(m.config.Name, &ackedIncident); err != nil {
+		return nil, err
+	}
+
+	m.state.SetIncident(&ackedIncident)
+	return &ackedIncident, nil
+}
+
+// UpdateIncidents opens an incident when a validator gets jailed or reaches the incidents
+// threshold group, and resolves it once the validator is back below it or is unjailed.
+func (m *Manager) UpdateIncidents(report *types.Report, now time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, event := range report.Events {
+		operatorAddress := event.GetValidator().OperatorAddress
+		incident, found := m.state.GetIncident(operatorAddress)
+
+		switch entry := event.(type) {
+		case events.ValidatorGroupChanged:
+			if m.config.IsIncidentGroup(entry.MissedBlocksGroupAfter) && !found {
+				m.openIncident(operatorAddress, now)
+			} else if !m.config.IsIncidentGroup(entry.MissedBlocksGroupAfter) && found {
+				m.resolveIncident(incident, types.IncidentRecovered, now)
+			}
+		case events.ValidatorJailed:
+			if !found {
+				m.openIncident(operatorAddress, now)
+			}
+		case events.ValidatorUnjailed:
+			if found {
+				m.resolveIncident(incident, types.IncidentRecovered, now)
+			}
+		case events.ValidatorTombstoned:
+			if found {
+				m.resolveIncident(incident, types.IncidentTombstoned, now)
+			}
+		}
+	}
+}
+
+// GetIncidentReminders returns a reminder for each incident nobody acknowledged yet,
+// if the validator is still in the incidents threshold group and the reminder interval
+// has passed since the incident start or the previous reminder.
+func (m *Manager) GetIncidentReminders(height int64, now time.Time) []types.ReportEvent {
+	reminders := make([]types.ReportEvent, 0)
+
+	if !m.config.IncidentsConfig.RemindersEnabled() {
+		return reminders
+	}
+
+	snapshot, found := m.snapshotManager.GetNewerSnapshot()
+	if !found {
+		return reminders
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, incident := range m.state.GetIncidents() {
+		if incident.IsAcked() {
+			continue
+		}
+
+		if now.Sub(incident.GetLastRemindedAt()) < m.config.IncidentsConfig.GetRemindInterval() {
+			continue
+		}
+
+		if window, found := m.state.GetMaintenanceWindow(incident.OperatorAddress); found && window.IsActive(now, height) {
+			continue
+		}
+
+		entry, found := snapshot.Entries[incident.OperatorAddress]
+		if !found || !entry.IsActive || entry.Validator.Jailed {
+			continue
+		}
+
+		missedBlocks := entry.SignatureInfo.GetNotSigned()
+		group, _, err := m.config.MissedBlocksGroups.GetGroup(missedBlocks)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("Could not get missed blocks group")
+			continue
+		}
+
+		if !m.config.IsIncidentGroup(group) {
+			continue
+		}
+
+		// the reminder is sent even if its time could not be stored,
+		// at worst it is sent once more after a restart
+		remindedIncident := *incident
+		remindedIncident.RemindedAt = now
+		if err := m.database.UpdateIncidentReminder(m.config.Name, &remindedIncident); err != nil {
+			m.logger.Error().Err(err).Int64("id", incident.ID).Msg("Could not store incident reminder time")
+		}
+
+		m.state.SetIncident(&remindedIncident)
+		reminders = append(reminders, events.ValidatorIncidentReminder{
+			Validator:         entry.Validator,
+			MissedBlocks:      missedBlocks,
+			MissedBlocksGroup: group,
+			Duration:          now.Sub(incident.StartedAt),
+			TimeToJail:        m.GetTimeTillJail(missedBlocks),
+		})
+	}
+
+	return reminders
+}
+
+// GetIncidentMessage returns the message to post or to edit for the event if it is a step
+// of a validator's incident, either of the ongoing one, or of the one it has just resolved.
+func (m *Manager) GetIncidentMessage(
+	event types.ReportEvent,
+	reporter constants.ReporterName,
+	destination string,
+) (*types.IncidentMessage, bool) {
+	if !m.config.IncidentsConfig.EditMessages.Bool {
+		return nil, false
+	}
+
+	step, ok := getIncidentStep(event)
+	if !ok {
+		return nil, false
+	}
+
+	operatorAddress := event.GetValidator().OperatorAddress
+	incident, isOpen := m.state.GetIncident(operatorAddress)
+	stored, found := m.state.GetIncidentMessage(reporter, destination, operatorAddress)
+
+	message := &types.IncidentMessage{
+		OperatorAddress: operatorAddress,
+		Reporter:        reporter,
+		Destination:     destination,
+	}
+
+	switch {
+	case isOpen && found && stored.IncidentID == incident.ID:
+		*message = *stored
+		message.Progression = append([]string{}, stored.Progression...)
+	case isOpen:
+		message.IncidentID = incident.ID
+	case found:
+		*message = *stored
+		message.Progression = append([]string{}, stored.Progression...)
+		message.Resolved = true
+		message.Outcome = getIncidentOutcome(event)
+	default:
+		return nil, false
+	}
+
+	message.Progression = append(message.Progression, step)
+	return message, true
+}
+
+// SaveIncidentMessage stores the message to edit it on the next incident step,
+// or forgets about it once the incident is resolved.
+func (m *Manager) SaveIncidentMessage(message *types.IncidentMessage) error {
+	if message.Resolved {
+		m.state.RemoveIncidentMessage(message)
+		return m.database.RemoveIncidentMessage(m.config.Name, message)
+	}
+
+	if err := m.database.UpsertIncidentMessage(m.config.Name, message); err != nil {
+		return err
+	}
+
+	m.state.SetIncidentMessage(message)
+	return nil
+}
+
+func getIncidentStep(event types.ReportEvent) (string, bool) {
+	switch entry := event.(type) {
+	case events.ValidatorGroupChanged:
+		return fmt.Sprintf("%s %d", entry.GetEmoji(), entry.MissedBlocksAfter), true
+	case events.ValidatorJailed:
+		return "jailed", true
+	case events.ValidatorUnjailed:
+		return "unjailed", true
+	case events.ValidatorTombstoned:
+		return "tombstoned", true
+	default:
+		return "", false
+	}
+}
+
+func getIncidentOutcome(event types.ReportEvent) types.IncidentOutcome {
+	if _, ok := event.(events.ValidatorTombstoned); ok {
+		return types.IncidentTombstoned
+	}
+
+	return types.IncidentRecovered
+}
+
+func (m *Manager) openIncident(operatorAddress string, now time.Time) {
+	incident := &types.Incident{OperatorAddress: operatorAddress, StartedAt: now}
+	if err := m.database.InsertIncident(m.config.Name, incident); err != nil {
+		m.logger.Error().Err(err).Str("valoper", operatorAddress).Msg("Could not open incident")
+		return
+	}
+
+	m.state.SetIncident(incident)
+	m.logger.Info().
+		Str("valoper", operatorAddress).
+		Int64("id", incident.ID).
+		Msg("Opened incident")
+}
+
+func (m *Manager) resolveIncident(incident *types.Incident, outcome types.IncidentOutcome, now time.Time) {
+	if err := m.database.ResolveIncident(m.config.Name, incident.ID, now, outcome); err != nil {
+		m.logger.Error().Err(err).Str("valoper", incident.OperatorAddress).Msg("Could not resolve incident")
+		return
+	}
+
+	m.state.RemoveIncident(incident.OperatorAddress)
+	m.logger.Info().
+		Str("valoper", incident.OperatorAddress).
+		Int64("id", incident.ID).
+		Str("outcome", string(outcome)).
+		Msg("Resolved incident")
+}
+
 func (m *Manager) GetValidator(operatorAddress string) (*types.Validator, bool) {
 	return m.state.GetValidator(operatorAddress)
 }
@@ -234,6 +734,11 @@ func (m *Manager) GetValidatorMissedBlocks(validator *types.Validator) (types.Si
 	return m.state.GetValidatorMissedBlocks(validator, blocksToCheck)
 }
 
+func (m *Manager) GetValidatorBlocksSignatures(validator *types.Validator) []types.BlockSignature {
+	blocksToCheck := utils.MinInt64(m.config.BlocksWindow, m.GetLastBlockHeight()-m.config.FirstBlock-1)
+	return m.state.GetValidatorBlocksSignatures(validator, blocksToCheck)
+}
+
 func (m *Manager) SetValidators(validators types.ValidatorsMap) {
 	m.state.SetValidators(validators)
 }
@@ -0,0 +1,114 @@
+package state
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	databasePkg "main/pkg/database"
+	"main/pkg/events"
+	loggerPkg "main/pkg/logger"
+	"main/pkg/types"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func getTestIncidentsManager(t *testing.T) *Manager {
+	t.Helper()
+
+	logger := loggerPkg.GetNopLogger()
+	database := databasePkg.NewDatabase(*logger, configPkg.DatabaseConfig{
+		Type: constants.DatabaseTypeSqlite,
+		Path: filepath.Join(t.TempDir(), "database.sqlite"),
+	})
+	database.Init()
+
+	config := &configPkg.ChainConfig{
+		Name:            "chain",
+		IncidentsConfig: configPkg.IncidentsConfig{EditMessages: null.BoolFrom(true)},
+	}
+
+	return NewManager(*logger, config, nil, nil, database)
+}
+
+func TestUpdateIncidentsTombstoned(t *testing.T) {
+	t.Parallel()
+
+	manager := getTestIncidentsManager(t)
+	validator := &types.Validator{OperatorAddress: "address1"}
+	now := time.Unix(1000, 0)
+
+	manager.UpdateIncidents(&types.Report{Events: []types.ReportEvent{
+		events.ValidatorJailed{Validator: validator},
+	}}, now)
+
+	incident, found := manager.state.GetIncident("address1")
+	require.True(t, found)
+
+	manager.state.SetIncidentMessage(&types.IncidentMessage{
+		IncidentID:      incident.ID,
+		OperatorAddress: "address1",
+		Reporter:        constants.TelegramReporterName,
+		Destination:     "chat",
+		MessageID:       "123",
+		Progression:     []string{"jailed"},
+	})
+
+	tombstoned := events.ValidatorTombstoned{Validator: validator}
+	manager.UpdateIncidents(&types.Report{Events: []types.ReportEvent{tombstoned}}, now.Add(time.Minute))
+
+	_, found = manager.state.GetIncident("address1")
+	require.False(t, found)
+
+	message, ok := manager.GetIncidentMessage(tombstoned, constants.TelegramReporterName, "chat")
+	require.True(t, ok)
+	assert.True(t, message.Resolved)
+	assert.Equal(t, types.IncidentTombstoned, message.Outcome)
+	assert.Equal(t, []string{"jailed", "tombstoned"}, message.Progression)
+
+	emoji, line := message.GetClosingLine()
+	assert.Equal(t, "💀", emoji)
+	assert.Equal(t, "Incident is closed, the validator is tombstoned", line)
+}
+
+func TestUpdateIncidentsUnjailed(t *testing.T) {
+	t.Parallel()
+
+	manager := getTestIncidentsManager(t)
+	validator := &types.Validator{OperatorAddress: "address1"}
+	now := time.Unix(1000, 0)
+
+	manager.UpdateIncidents(&types.Report{Events: []types.ReportEvent{
+		events.ValidatorJailed{Validator: validator},
+	}}, now)
+
+	incident, found := manager.state.GetIncident("address1")
+	require.True(t, found)
+
+	manager.state.SetIncidentMessage(&types.IncidentMessage{
+		IncidentID:      incident.ID,
+		OperatorAddress: "address1",
+		Reporter:        constants.TelegramReporterName,
+		Destination:     "chat",
+		MessageID:       "123",
+		Progression:     []string{"jailed"},
+	})
+
+	unjailed := events.ValidatorUnjailed{Validator: validator}
+	manager.UpdateIncidents(&types.Report{Events: []types.ReportEvent{unjailed}}, now.Add(time.Minute))
+
+	_, found = manager.state.GetIncident("address1")
+	require.False(t, found)
+
+	message, ok := manager.GetIncidentMessage(unjailed, constants.TelegramReporterName, "chat")
+	require.True(t, ok)
+	assert.True(t, message.Resolved)
+	assert.Equal(t, types.IncidentRecovered, message.Outcome)
+
+	emoji, line := message.GetClosingLine()
+	assert.Equal(t, "✅", emoji)
+	assert.Equal(t, "Incident is over", line)
+}
@@ -5,6 +5,8 @@ import (
 	"main/pkg/config"
 	"main/pkg/constants"
 	"main/pkg/types"
+	"main/pkg/utils"
+	"sort"
 	"sync"
 	"time"
 )
@@ -16,18 +18,24 @@ type LastBlockHeight struct {
 }
 
 type State struct {
-	blocks          *Blocks
-	validators      types.ValidatorsMap
-	notifiers       *types.Notifiers
-	lastBlockHeight *LastBlockHeight
-	mutex           sync.RWMutex
+	blocks             *Blocks
+	validators         types.ValidatorsMap
+	notifiers          *types.Notifiers
+	maintenanceWindows map[string]*types.MaintenanceWindow
+	incidents          map[string]*types.Incident
+	incidentMessages   map[string]*types.IncidentMessage
+	lastBlockHeight    *LastBlockHeight
+	mutex              sync.RWMutex
 }
 
 func NewState() *State {
 	return &State{
-		blocks:     NewBlocks(),
-		validators: make(types.ValidatorsMap),
-		notifiers:  &types.Notifiers{},
+		blocks:             NewBlocks(),
+		validators:         make(types.ValidatorsMap),
+		notifiers:          &types.Notifiers{},
+		maintenanceWindows: make(map[string]*types.MaintenanceWindow),
+		incidents:          make(map[string]*types.Incident),
+		incidentMessages:   make(map[string]*types.IncidentMessage),
 		lastBlockHeight: &LastBlockHeight{
 			signingInfos: 0,
 			validators:   0,
@@ -70,6 +78,161 @@ func (s *State) SetNotifiers(notifiers *types.Notifiers) {
 	s.notifiers = notifiers
 }
 
+func (s *State) SetMaintenanceWindows(windows []*types.MaintenanceWindow) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.maintenanceWindows = make(map[string]*types.MaintenanceWindow, len(windows))
+	for _, window := range windows {
+		s.maintenanceWindows[window.OperatorAddress] = window
+	}
+}
+
+func (s *State) SetMaintenanceWindow(window *types.MaintenanceWindow) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.maintenanceWindows[window.OperatorAddress] = window
+}
+
+func (s *State) RemoveMaintenanceWindow(operatorAddress string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, found := s.maintenanceWindows[operatorAddress]; !found {
+		return false
+	}
+
+	delete(s.maintenanceWindows, operatorAddress)
+	return true
+}
+
+func (s *State) GetMaintenanceWindow(operatorAddress string) (*types.MaintenanceWindow, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	window, found := s.maintenanceWindows[operatorAddress]
+	return window, found
+}
+
+func (s *State) GetMaintenanceWindows() []*types.MaintenanceWindow {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	windows := utils.MapToArray(s.maintenanceWindows)
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].OperatorAddress < windows[j].OperatorAddress
+	})
+
+	return windows
+}
+
+func (s *State) SetIncidents(incidents []*types.Incident) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.incidents = make(map[string]*types.Incident, len(incidents))
+	for _, incident := range incidents {
+		s.incidents[incident.OperatorAddress] = incident
+	}
+}
+
+func (s *State) SetIncident(incident *types.Incident) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.incidents[incident.OperatorAddress] = incident
+}
+
+func (s *State) RemoveIncident(operatorAddress string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, found := s.incidents[operatorAddress]; !found {
+		return false
+	}
+
+	delete(s.incidents, operatorAddress)
+	return true
+}
+
+func (s *State) GetIncident(operatorAddress string) (*types.Incident, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	incident, found := s.incidents[operatorAddress]
+	return incident, found
+}
+
+func (s *State) GetIncidents() []*types.Incident {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	incidents := utils.MapToArray(s.incidents)
+	sort.Slice(incidents, func(i, j int) bool {
+		return incidents[i].ID < incidents[j].ID
+	})
+
+	return incidents
+}
+
+func (s *State) GetIncidentByID(incidentID int64) (*types.Incident, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, incident := range s.incidents {
+		if incident.ID == incidentID {
+			return incident, true
+		}
+	}
+
+	return nil, false
+}
+
+func incidentMessageKey(
+	reporter constants.ReporterName,
+	destination string,
+	operatorAddress string,
+) string {
+	return fmt.Sprintf("%s|%s|%s", reporter, destination, operatorAddress)
+}
+
+func (s *State) SetIncidentMessages(messages []*types.IncidentMessage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.incidentMessages = make(map[string]*types.IncidentMessage, len(messages))
+	for _, message := range messages {
+		s.incidentMessages[incidentMessageKey(message.Reporter, message.Destination, message.OperatorAddress)] = message
+	}
+}
+
+func (s *State) SetIncidentMessage(message *types.IncidentMessage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.incidentMessages[incidentMessageKey(message.Reporter, message.Destination, message.OperatorAddress)] = message
+}
+
+func (s *State) RemoveIncidentMessage(message *types.IncidentMessage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.incidentMessages, incidentMessageKey(message.Reporter, message.Destination, message.OperatorAddress))
+}
+
+func (s *State) GetIncidentMessage(
+	reporter constants.ReporterName,
+	destination string,
+	operatorAddress string,
+) (*types.IncidentMessage, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	message, found := s.incidentMessages[incidentMessageKey(reporter, destination, operatorAddress)]
+	return message, found
+}
+
 func (s *State) SetBlocks(blocks map[int64]*types.Block) {
 	s.blocks.SetBlocks(blocks)
 }
@@ -95,11 +258,12 @@ func (s *State) AddNotifier(
 	reporter constants.ReporterName,
 	userID string,
 	userName string,
+	filters types.NotifierFilters,
 ) bool {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	notifiers, added := s.notifiers.AddNotifier(operatorAddress, reporter, userID, userName)
+	notifiers, added := s.notifiers.AddNotifier(operatorAddress, reporter, userID, userName, filters)
 	if added {
 		s.notifiers = notifiers
 	}
@@ -123,10 +287,136 @@ func (s *State) RemoveNotifier(
 	return removed
 }
 
+func (s *State) GetNotifierDeliveryMode(
+	reporter constants.ReporterName,
+	userID string,
+) constants.DeliveryMode {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.notifiers.GetDeliveryMode(reporter, userID)
+}
+
+func (s *State) SetNotifierDeliveryMode(
+	reporter constants.ReporterName,
+	userID string,
+	deliveryMode constants.DeliveryMode,
+) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	notifiers, found := s.notifiers.SetDeliveryMode(reporter, userID, deliveryMode)
+	if found {
+		s.notifiers = notifiers
+	}
+
+	return found
+}
+
+func (s *State) GetNotifierLanguage(
+	reporter constants.ReporterName,
+	userID string,
+) constants.Language {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.notifiers.GetLanguage(reporter, userID)
+}
+
+func (s *State) SetNotifierLanguage(
+	reporter constants.ReporterName,
+	userID string,
+	language constants.Language,
+) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	notifiers, found := s.notifiers.SetLanguage(reporter, userID, language)
+	if found {
+		s.notifiers = notifiers
+	}
+
+	return found
+}
+
+func (s *State) GetNotifierQuietHours(
+	reporter constants.ReporterName,
+	userID string,
+) types.QuietHours {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.notifiers.GetQuietHours(reporter, userID)
+}
+
+func (s *State) SetNotifierQuietHours(
+	reporter constants.ReporterName,
+	userID string,
+	quietHours types.QuietHours,
+) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	notifiers, found := s.notifiers.SetQuietHours(reporter, userID, quietHours)
+	if found {
+		s.notifiers = notifiers
+	}
+
+	return found
+}
+
+func (s *State) SetNotifierMutedUntil(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	mutedUntil time.Time,
+) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	notifiers, found := s.notifiers.SetMutedUntil(operatorAddress, reporter, userID, mutedUntil)
+	if found {
+		s.notifiers = notifiers
+	}
+
+	return found
+}
+
+func (s *State) SetNotifierFilters(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	filters types.NotifierFilters,
+) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	notifiers, found := s.notifiers.SetFilters(operatorAddress, reporter, userID, filters)
+	if found {
+		s.notifiers = notifiers
+	}
+
+	return found
+}
+
+func (s *State) GetDirectMessages(
+	events []types.ReportEvent,
+	reporter constants.ReporterName,
+	blocksWindow int64,
+) []*types.DirectMessage {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.notifiers.GetDirectMessages(events, reporter, blocksWindow)
+}
+
 func (s *State) GetNotifiersForReporter(
 	operatorAddress string,
 	reporter constants.ReporterName,
 ) []*types.Notifier {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	return s.notifiers.GetNotifiersForReporter(operatorAddress, reporter)
 }
 
@@ -134,15 +424,18 @@ func (s *State) GetValidatorsForNotifier(
 	reporter constants.ReporterName,
 	notifier string,
 ) []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
 	return s.notifiers.GetValidatorsForNotifier(reporter, notifier)
 }
 
 func (s *State) GetLastBlockHeight() int64 {
-	return s.blocks.lastHeight
+	return s.blocks.GetLastHeight()
 }
 
 func (s *State) GetLastBlock() *types.Block {
-	return s.blocks.blocks[s.blocks.lastHeight]
+	return s.blocks.GetLatestBlock()
 }
 
 func (s *State) GetValidators() types.ValidatorsMap {
@@ -165,8 +458,10 @@ func (s *State) GetValidatorMissedBlocks(
 
 	errors := 0
 
-	for height := s.blocks.lastHeight; height > s.blocks.lastHeight-blocksToCheck; height-- {
-		block, exists := s.blocks.GetBlock(height)
+	lastHeight, blocks := s.blocks.GetLatestBlocks(blocksToCheck)
+
+	for height := lastHeight; height > lastHeight-blocksToCheck; height-- {
+		block, exists := blocks[height]
 		if !exists {
 			errors += 1
 			continue
@@ -212,18 +507,47 @@ func (s *State) GetValidatorMissedBlocks(
 	return signatureInfo, nil
 }
 
+// GetValidatorBlocksSignatures returns how the validator took part in each of the last blocks,
+// from the earliest to the latest, with the blocks that are not stored marked as unknown.
+func (s *State) GetValidatorBlocksSignatures(
+	validator *types.Validator,
+	blocksToCheck int64,
+) []types.BlockSignature {
+	lastHeight, blocks := s.blocks.GetLatestBlocks(blocksToCheck)
+	signatures := make([]types.BlockSignature, 0, utils.MaxInt64(blocksToCheck, 0))
+
+	for height := lastHeight - blocksToCheck + 1; height <= lastHeight; height++ {
+		signature := types.BlockSignature{Height: height, Status: constants.BlockStatusUnknown}
+
+		if block, exists := blocks[height]; exists {
+			value, signed := block.Signatures[validator.ConsensusAddressHex]
+
+			switch {
+			case !block.Validators[validator.ConsensusAddressHex]:
+				signature.Status = constants.BlockStatusNotActive
+			case signed && (value == constants.ValidatorSigned || value == constants.ValidatorNilSignature):
+				signature.Status = constants.BlockStatusSigned
+			default:
+				signature.Status = constants.BlockStatusMissed
+			}
+		}
+
+		signatures = append(signatures, signature)
+	}
+
+	return signatures
+}
+
 func (s *State) GetEarliestBlock() *types.Block {
 	return s.blocks.GetEarliestBlock()
 }
 
 func (s *State) GetBlockTime() time.Duration {
-	latestHeight := s.blocks.lastHeight
 	latestBlock := s.blocks.GetLatestBlock()
 
 	earliestBlock := s.GetEarliestBlock()
-	earliestHeight := earliestBlock.Height
 
-	heightDiff := latestHeight - earliestHeight
+	heightDiff := latestBlock.Height - earliestBlock.Height
 	timeDiff := latestBlock.Time.Sub(earliestBlock.Time)
 
 	timeDiffNano := timeDiff.Nanoseconds()
@@ -3,6 +3,7 @@ package state
 import (
 	configPkg "main/pkg/config"
 	"main/pkg/constants"
+	"main/pkg/events"
 	"main/pkg/types"
 	"testing"
 	"time"
@@ -54,7 +55,7 @@ func TestAddNotifierIfExists(t *testing.T) {
 		},
 	})
 
-	added := state.AddNotifier("address", constants.TelegramReporterName, "id", "notifier")
+	added := state.AddNotifier("address", constants.TelegramReporterName, "id", "notifier", types.NotifierFilters{})
 
 	assert.False(t, added, "Notifiers should not be added")
 	assert.Equal(t, 1, state.notifiers.Length(), "New notifier should not be added!")
@@ -73,9 +74,11 @@ func TestAddNotifierIfNotExists(t *testing.T) {
 		},
 	})
 
-	added := state.AddNotifier("address", constants.TelegramReporterName, "id2", "newnotifier")
+	filters := types.NotifierFilters{MinMissedPercent: 10}
+	added := state.AddNotifier("address", constants.TelegramReporterName, "id2", "newnotifier", filters)
 	assert.True(t, added, "Notifiers should be added")
 	assert.Equal(t, 2, state.notifiers.Length(), "New notifier should be added!")
+	assert.Equal(t, filters, state.GetNotifiersForReporter("address", constants.TelegramReporterName)[1].Filters)
 }
 
 func TestGetNotifiersForReporter(t *testing.T) {
@@ -162,6 +165,248 @@ func TestRemoveNotifierIfExists(t *testing.T) {
 	assert.Equal(t, 0, state.notifiers.Length(), "New notifier should be removed!")
 }
 
+func TestSetNotifierDeliveryMode(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetNotifiers(&types.Notifiers{
+		&types.Notifier{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier",
+			UserID:          "id",
+		},
+	})
+
+	assert.Equal(t, constants.DeliveryModeChannel, state.GetNotifierDeliveryMode(constants.TelegramReporterName, "id"))
+
+	found := state.SetNotifierDeliveryMode(constants.TelegramReporterName, "id", constants.DeliveryModeBoth)
+	assert.True(t, found, "Notifier should be found")
+	assert.Equal(t, constants.DeliveryModeBoth, state.GetNotifierDeliveryMode(constants.TelegramReporterName, "id"))
+
+	found = state.SetNotifierDeliveryMode(constants.TelegramReporterName, "id2", constants.DeliveryModeBoth)
+	assert.False(t, found, "Notifier should not be found")
+}
+
+func TestSetNotifierLanguage(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetNotifiers(&types.Notifiers{
+		&types.Notifier{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier",
+			UserID:          "id",
+		},
+	})
+
+	assert.Empty(t, state.GetNotifierLanguage(constants.TelegramReporterName, "id"))
+
+	found := state.SetNotifierLanguage(constants.TelegramReporterName, "id", constants.LanguageRussian)
+	assert.True(t, found, "Notifier should be found")
+	assert.Equal(t, constants.LanguageRussian, state.GetNotifierLanguage(constants.TelegramReporterName, "id"))
+
+	found = state.SetNotifierLanguage(constants.TelegramReporterName, "id2", constants.LanguageRussian)
+	assert.False(t, found, "Notifier should not be found")
+}
+
+func TestSetNotifierMutedUntil(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetNotifiers(&types.Notifiers{
+		&types.Notifier{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier",
+			UserID:          "id",
+		},
+	})
+
+	mutedUntil := time.Now().Add(time.Hour)
+
+	found := state.SetNotifierMutedUntil("address", constants.TelegramReporterName, "id", mutedUntil)
+	assert.True(t, found, "Notifier should be found")
+	assert.True(t, state.GetNotifiersForReporter("address", constants.TelegramReporterName)[0].IsMuted(time.Now()))
+
+	found = state.SetNotifierMutedUntil("address2", constants.TelegramReporterName, "id", mutedUntil)
+	assert.False(t, found, "Notifier should not be found")
+}
+
+func TestSetNotifierQuietHours(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetNotifiers(&types.Notifiers{
+		&types.Notifier{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier",
+			UserID:          "id",
+		},
+	})
+
+	assert.False(t, state.GetNotifierQuietHours(constants.TelegramReporterName, "id").Enabled())
+
+	quietHours := types.QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"}
+
+	found := state.SetNotifierQuietHours(constants.TelegramReporterName, "id", quietHours)
+	assert.True(t, found, "Notifier should be found")
+	assert.Equal(t, quietHours, state.GetNotifierQuietHours(constants.TelegramReporterName, "id"))
+
+	found = state.SetNotifierQuietHours(constants.TelegramReporterName, "id2", quietHours)
+	assert.False(t, found, "Notifier should not be found")
+}
+
+func TestMaintenanceWindows(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetMaintenanceWindows([]*types.MaintenanceWindow{
+		{OperatorAddress: "address2", StartHeight: 100, EndHeight: 200},
+		{OperatorAddress: "address1", StartHeight: 100, EndHeight: 200},
+	})
+
+	windows := state.GetMaintenanceWindows()
+	require.Len(t, windows, 2)
+	assert.Equal(t, "address1", windows[0].OperatorAddress)
+	assert.Equal(t, "address2", windows[1].OperatorAddress)
+
+	state.SetMaintenanceWindow(&types.MaintenanceWindow{OperatorAddress: "address1", StartHeight: 300, EndHeight: 400})
+	window, found := state.GetMaintenanceWindow("address1")
+	require.True(t, found)
+	assert.Equal(t, int64(300), window.StartHeight)
+
+	assert.True(t, state.RemoveMaintenanceWindow("address1"))
+	assert.False(t, state.RemoveMaintenanceWindow("address1"))
+
+	_, found = state.GetMaintenanceWindow("address1")
+	assert.False(t, found)
+	assert.Len(t, state.GetMaintenanceWindows(), 1)
+}
+
+func TestIncidents(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetIncidents([]*types.Incident{
+		{ID: 1, OperatorAddress: "address1"},
+		{ID: 2, OperatorAddress: "address2", AckedBy: "@user"},
+	})
+
+	incident, found := state.GetIncident("address2")
+	require.True(t, found)
+	assert.True(t, incident.IsAcked())
+
+	incident, found = state.GetIncidentByID(1)
+	require.True(t, found)
+	assert.Equal(t, "address1", incident.OperatorAddress)
+
+	_, found = state.GetIncidentByID(3)
+	assert.False(t, found)
+
+	state.SetIncident(&types.Incident{ID: 3, OperatorAddress: "address3"})
+	_, found = state.GetIncidentByID(3)
+	assert.True(t, found)
+
+	incidents := state.GetIncidents()
+	require.Len(t, incidents, 3)
+	assert.Equal(t, int64(1), incidents[0].ID)
+	assert.Equal(t, int64(3), incidents[2].ID)
+
+	assert.True(t, state.RemoveIncident("address1"))
+	assert.False(t, state.RemoveIncident("address1"))
+
+	_, found = state.GetIncident("address1")
+	assert.False(t, found)
+}
+
+func TestIncidentMessages(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetIncidentMessages([]*types.IncidentMessage{
+		{IncidentID: 1, OperatorAddress: "address1", Reporter: constants.TelegramReporterName, Destination: "chat1"},
+	})
+
+	_, found := state.GetIncidentMessage(constants.TelegramReporterName, "chat1", "address1")
+	require.True(t, found)
+
+	_, found = state.GetIncidentMessage(constants.TelegramReporterName, "chat2", "address1")
+	require.False(t, found)
+
+	_, found = state.GetIncidentMessage(constants.DiscordReporterName, "chat1", "address1")
+	require.False(t, found)
+
+	message := &types.IncidentMessage{
+		IncidentID:      2,
+		OperatorAddress: "address1",
+		Reporter:        constants.TelegramReporterName,
+		Destination:     "chat1",
+		MessageID:       "123",
+	}
+	state.SetIncidentMessage(message)
+
+	stored, found := state.GetIncidentMessage(constants.TelegramReporterName, "chat1", "address1")
+	require.True(t, found)
+	assert.Equal(t, "123", stored.MessageID)
+
+	state.RemoveIncidentMessage(message)
+	_, found = state.GetIncidentMessage(constants.TelegramReporterName, "chat1", "address1")
+	require.False(t, found)
+}
+
+func TestGetDirectMessages(t *testing.T) {
+	t.Parallel()
+
+	state := NewState()
+	state.SetNotifiers(&types.Notifiers{
+		&types.Notifier{
+			OperatorAddress: "address1",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier1",
+			UserID:          "id1",
+			DeliveryMode:    constants.DeliveryModeDM,
+		},
+		&types.Notifier{
+			OperatorAddress: "address2",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier1",
+			UserID:          "id1",
+			DeliveryMode:    constants.DeliveryModeDM,
+		},
+		&types.Notifier{
+			OperatorAddress: "address1",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier2",
+			UserID:          "id2",
+			DeliveryMode:    constants.DeliveryModeChannel,
+		},
+		&types.Notifier{
+			OperatorAddress: "address2",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier3",
+			UserID:          "id3",
+			DeliveryMode:    constants.DeliveryModeBoth,
+		},
+	})
+
+	reportEvents := []types.ReportEvent{
+		events.ValidatorJailed{Validator: &types.Validator{OperatorAddress: "address1"}},
+		events.ValidatorJailed{Validator: &types.Validator{OperatorAddress: "address2"}},
+		events.ValidatorJailed{Validator: &types.Validator{OperatorAddress: "address3"}},
+	}
+
+	messages := state.GetDirectMessages(reportEvents, constants.TelegramReporterName, 10000)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "id1", messages[0].UserID)
+	assert.Len(t, messages[0].Events, 2)
+	assert.Equal(t, "id3", messages[1].UserID)
+	assert.Len(t, messages[1].Events, 1)
+	assert.Equal(t, "address2", messages[1].Events[0].GetValidator().OperatorAddress)
+}
+
 func TestGetBlockTime(t *testing.T) {
 	t.Parallel()
 
@@ -352,3 +597,67 @@ func TestGetActiveValidators(t *testing.T) {
 	validator := validators2[0]
 	assert.Equal(t, "validator2", validator.OperatorAddress)
 }
+
+func TestGetIncidentStep(t *testing.T) {
+	t.Parallel()
+
+	step, ok := getIncidentStep(events.ValidatorGroupChanged{
+		MissedBlocksAfter:       5100,
+		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{Start: 2500},
+		MissedBlocksGroupAfter:  &configPkg.MissedBlocksGroup{Start: 5000, EmojiStart: "🔴"},
+	})
+	require.True(t, ok)
+	assert.Equal(t, "🔴 5100", step)
+
+	step, ok = getIncidentStep(events.ValidatorJailed{})
+	require.True(t, ok)
+	assert.Equal(t, "jailed", step)
+
+	_, ok = getIncidentStep(events.ValidatorChangedMoniker{})
+	assert.False(t, ok)
+}
+
+func TestValidatorBlocksSignatures(t *testing.T) {
+	t.Parallel()
+
+	validator := &types.Validator{ConsensusAddressHex: "address"}
+	state := NewState()
+
+	state.AddBlock(&types.Block{Height: 2, Signatures: map[string]int32{"address": 2}, Validators: map[string]bool{"address": true}})
+	state.AddBlock(&types.Block{Height: 3, Signatures: map[string]int32{"address": 1}, Validators: map[string]bool{"address": true}})
+	state.AddBlock(&types.Block{Height: 4, Signatures: map[string]int32{}, Validators: map[string]bool{}})
+	state.AddBlock(&types.Block{Height: 5, Signatures: map[string]int32{"address": 3}, Validators: map[string]bool{"address": true}})
+
+	signatures := state.GetValidatorBlocksSignatures(validator, 5)
+
+	assert.Equal(t, []types.BlockSignature{
+		{Height: 1, Status: constants.BlockStatusUnknown},
+		{Height: 2, Status: constants.BlockStatusSigned},
+		{Height: 3, Status: constants.BlockStatusMissed},
+		{Height: 4, Status: constants.BlockStatusNotActive},
+		{Height: 5, Status: constants.BlockStatusSigned},
+	}, signatures)
+}
+
+func TestValidatorBlocksSignaturesConcurrentAdd(t *testing.T) {
+	t.Parallel()
+
+	validator := &types.Validator{ConsensusAddressHex: "address"}
+	state := NewState()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for height := int64(1); height <= 100; height++ {
+			state.AddBlock(&types.Block{Height: height, Validators: map[string]bool{"address": true}})
+		}
+	}()
+
+	for index := 0; index < 100; index++ {
+		signatures := state.GetValidatorBlocksSignatures(validator, 5)
+		assert.Len(t, signatures, 5)
+		_, _ = state.GetValidatorMissedBlocks(validator, 5)
+	}
+
+	<-done
+}
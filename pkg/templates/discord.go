@@ -7,9 +7,10 @@ import (
 	htmlTemplate "html/template"
 	"main/pkg/constants"
 	"main/pkg/events"
+	"main/pkg/i18n"
 	"main/pkg/types"
 	"main/pkg/utils"
-	"main/templates"
+	"path"
 	"strings"
 	"text/template"
 	"time"
@@ -18,22 +19,30 @@ import (
 )
 
 type DiscordTemplateManager struct {
-	Logger    zerolog.Logger
-	Templates map[string]interface{}
+	Logger       zerolog.Logger
+	Templates    *TemplatesCache
+	TemplatesDir string
+	Language     constants.Language
 }
 
-func NewDiscordTemplateManager(logger zerolog.Logger) *DiscordTemplateManager {
+func NewDiscordTemplateManager(
+	logger zerolog.Logger,
+	templatesDir string,
+	language constants.Language,
+) *DiscordTemplateManager {
 	return &DiscordTemplateManager{
 		Logger: logger.With().
 			Str("component", "templates_manager").
 			Str("reporter", "discord").
 			Logger(),
-		Templates: make(map[string]interface{}, 0),
+		TemplatesDir: templatesDir,
+		Language:     language,
+		Templates:    NewTemplatesCache(),
 	}
 }
 
 func (m *DiscordTemplateManager) GetTemplate(name string) (*template.Template, error) {
-	if cachedTemplate, ok := m.Templates[name]; ok {
+	if cachedTemplate, ok := m.Templates.Get(m.Language, name); ok {
 		m.Logger.Trace().Str("type", name).Msg("Using cached template")
 		if convertedTemplate, ok := cachedTemplate.(*template.Template); !ok {
 			return nil, errors.New("error converting template")
@@ -43,6 +52,9 @@ func (m *DiscordTemplateManager) GetTemplate(name string) (*template.Template, e
 	}
 
 	allSerializers := map[string]any{
+		"T":                         m.Translate,
+		"FormatPercent":             FormatPercent,
+		"FormatDuration":            m.FormatDuration,
 		"SerializeLink":             m.SerializeLink,
 		"SerializeDate":             m.SerializeDate,
 		"SerializeNotifier":         m.SerializeNotifier,
@@ -52,18 +64,25 @@ func (m *DiscordTemplateManager) GetTemplate(name string) (*template.Template, e
 
 	m.Logger.Trace().Str("type", name).Msg("Loading template")
 
-	t, err := template.New(name+".md").
+	templatePath := "discord/" + name + ".md"
+
+	t, err := template.New(path.Base(templatePath)).
 		Funcs(allSerializers).
-		ParseFS(templates.TemplatesFs, "discord/"+name+".md")
+		ParseFS(GetTemplatesFS(m.TemplatesDir, templatePath), templatePath)
 	if err != nil {
 		return nil, err
 	}
 
-	m.Templates[name] = t
+	m.Templates.Set(m.Language, name, t)
 
 	return t, nil
 }
 
+func (m *DiscordTemplateManager) ParseTemplateFile(filename string) error {
+	_, err := m.GetTemplate(strings.TrimSuffix(filename, ".md"))
+	return err
+}
+
 func (m *DiscordTemplateManager) Render(templateName string, data interface{}) (string, error) {
 	templateToRender, err := m.GetTemplate(templateName)
 	if err != nil {
@@ -109,8 +128,28 @@ func (m *DiscordTemplateManager) SerializeNotifier(notifier *types.Notifier) str
 	return fmt.Sprintf("<@%s>", notifier.UserID)
 }
 
+// WithLanguage returns the manager rendering templates in the language,
+// or this manager if the language is not set.
+func (m *DiscordTemplateManager) WithLanguage(language constants.Language) Manager {
+	if language == "" || language == m.Language {
+		return m
+	}
+
+	localized := *m
+	localized.Language = language
+	return &localized
+}
+
+func (m *DiscordTemplateManager) Translate(message string, args ...any) string {
+	return i18n.GetLocalizer(m.Language).Translate(message, args...)
+}
+
+func (m *DiscordTemplateManager) FormatDuration(duration time.Duration) string {
+	return i18n.GetLocalizer(m.Language).FormatDuration(duration)
+}
+
 func (m *DiscordTemplateManager) SerializeDate(date time.Time) string {
-	return date.Format(time.RFC822)
+	return i18n.GetLocalizer(m.Language).FormatDate(date)
 }
 
 func (m *DiscordTemplateManager) GetValidatorLink(validatorLink types.Link) htmlTemplate.HTML {
@@ -130,16 +169,21 @@ func (m *DiscordTemplateManager) GetValidatorLink(validatorLink types.Link) html
 
 func (m *DiscordTemplateManager) SerializeEvent(event types.RenderEventItem) string {
 	renderData := types.ReportEventRenderData{
-		Notifiers:     m.SerializeNotifiers(event.Notifiers),
+		Notifiers:     htmlTemplate.HTML(m.SerializeNotifiers(event.Notifiers)),
 		ValidatorLink: m.GetValidatorLink(event.ValidatorLink),
 	}
 
 	switch entry := event.Event.(type) {
 	case events.ValidatorGroupChanged:
 		if entry.IsIncreasing() {
-			renderData.TimeToJail = fmt.Sprintf(" (%s till jail)", utils.FormatDuration(event.TimeToJail))
+			renderData.TimeToJail = event.TimeToJail
 		}
 	}
 
-	return event.Event.Render(constants.FormatTypeMarkdown, renderData)
+	rendered := RenderEvent(m, event.Event, renderData)
+	if ackedBy := event.GetAckedBy(); ackedBy != "" {
+		rendered = fmt.Sprintf("%s (ack'd by `%s`)", strings.TrimSpace(rendered), ackedBy)
+	}
+
+	return rendered
 }
@@ -0,0 +1,177 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/i18n"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type EmailTemplateManager struct {
+	Logger       zerolog.Logger
+	Templates    *TemplatesCache
+	TemplatesDir string
+	Language     constants.Language
+}
+
+func NewEmailTemplateManager(
+	logger zerolog.Logger,
+	templatesDir string,
+	language constants.Language,
+) *EmailTemplateManager {
+	return &EmailTemplateManager{
+		Logger: logger.With().
+			Str("component", "templates_manager").
+			Str("reporter", "email").
+			Logger(),
+		TemplatesDir: templatesDir,
+		Language:     language,
+		Templates:    NewTemplatesCache(),
+	}
+}
+
+func (m *EmailTemplateManager) GetHTMLTemplate(filename string) (*template.Template, error) {
+	if cachedTemplate, ok := m.Templates.Get(m.Language, filename); ok {
+		m.Logger.Trace().Str("type", filename).Msg("Using cached template")
+		if convertedTemplate, ok := cachedTemplate.(*template.Template); !ok {
+			return nil, errors.New("error converting template")
+		} else {
+			return convertedTemplate, nil
+		}
+	}
+
+	m.Logger.Trace().Str("type", filename).Msg("Loading template")
+
+	allSerializers := map[string]any{
+		"T":                  m.TranslateHTML,
+		"FormatPercent":      FormatPercent,
+		"FormatDuration":     m.FormatDuration,
+		"SerializeLink":      m.SerializeLink,
+		"SerializeDate":      m.SerializeDate,
+		"SerializeNotifier":  m.SerializeNotifier,
+		"SerializeNotifiers": m.SerializeNotifiers,
+	}
+
+	templatePath := "email/" + filename
+
+	t, err := template.New(path.Base(templatePath)).
+		Funcs(allSerializers).
+		ParseFS(GetTemplatesFS(m.TemplatesDir, templatePath), templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Templates.Set(m.Language, filename, t)
+
+	return t, nil
+}
+
+func (m *EmailTemplateManager) ParseTemplateFile(filename string) error {
+	_, err := m.GetHTMLTemplate(filename)
+	return err
+}
+
+func (m *EmailTemplateManager) RenderFile(filename string, data interface{}) (string, error) {
+	templateToRender, err := m.GetHTMLTemplate(filename)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("type", filename).Msg("Error loading template")
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	err = templateToRender.Execute(&buffer, data)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("type", filename).Msg("Error rendering template")
+		return "", err
+	}
+
+	return buffer.String(), err
+}
+
+func (m *EmailTemplateManager) Render(templateName string, data interface{}) (string, error) {
+	return m.RenderFile(templateName+".html", data)
+}
+
+// RenderText renders a plain-text template. It is executed with html/template as well,
+// so the escaped entities are converted back afterwards.
+func (m *EmailTemplateManager) RenderText(templateName string, data interface{}) (string, error) {
+	rendered, err := m.RenderFile(templateName+".txt", data)
+	if err != nil {
+		return "", err
+	}
+
+	return html.UnescapeString(rendered), nil
+}
+
+// WithLanguage returns the manager rendering templates in the language,
+// or this manager if the language is not set.
+func (m *EmailTemplateManager) WithLanguage(language constants.Language) Manager {
+	if language == "" || language == m.Language {
+		return m
+	}
+
+	localized := *m
+	localized.Language = language
+	return &localized
+}
+
+func (m *EmailTemplateManager) Translate(message string, args ...any) string {
+	return i18n.GetLocalizer(m.Language).Translate(message, args...)
+}
+
+func (m *EmailTemplateManager) TranslateHTML(message string, args ...any) template.HTML {
+	return TranslateHTML(i18n.GetLocalizer(m.Language), message, args...)
+}
+
+func (m *EmailTemplateManager) FormatDuration(duration time.Duration) string {
+	return i18n.GetLocalizer(m.Language).FormatDuration(duration)
+}
+
+func (m *EmailTemplateManager) SerializeDate(date time.Time) string {
+	return i18n.GetLocalizer(m.Language).FormatDate(date)
+}
+
+func (m *EmailTemplateManager) SerializeLink(link types.Link) template.HTML {
+	if link.Href == "" {
+		return template.HTML(html.EscapeString(link.Text))
+	}
+
+	return template.HTML(fmt.Sprintf("<a href=\"%s\">%s</a>", link.Href, html.EscapeString(link.Text)))
+}
+
+func (m *EmailTemplateManager) SerializeNotifiers(notifiers types.Notifiers) string {
+	notifiersNormalized := utils.Map(notifiers, m.SerializeNotifier)
+
+	return strings.Join(notifiersNormalized, " ")
+}
+
+func (m *EmailTemplateManager) SerializeNotifier(notifier *types.Notifier) string {
+	return notifier.UserName
+}
+
+func (m *EmailTemplateManager) SerializeEvent(event types.RenderEventItem) string {
+	renderData := types.ReportEventRenderData{
+		Notifiers:     template.HTML(m.SerializeNotifiers(event.Notifiers)),
+		ValidatorLink: m.SerializeLink(event.ValidatorLink),
+	}
+
+	switch entry := event.Event.(type) {
+	case events.ValidatorGroupChanged:
+		if entry.IsIncreasing() {
+			renderData.TimeToJail = event.TimeToJail
+		}
+	}
+
+	return RenderEvent(m, event.Event, renderData)
+}
@@ -0,0 +1,31 @@
+package templates
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/types"
+	"strings"
+)
+
+// GetEventTemplateName returns the name of the template an event is rendered with,
+// like "events/ValidatorJailed".
+func GetEventTemplateName(eventName constants.EventName) string {
+	return "events/" + string(eventName)
+}
+
+// RenderEvent renders the event with its reporter template, like "telegram/events/ValidatorJailed.html".
+func RenderEvent(manager Manager, event types.ReportEvent, renderData types.ReportEventRenderData) string {
+	renderData.Event = event
+
+	rendered, err := manager.Render(GetEventTemplateName(event.Type()), renderData)
+	if err != nil {
+		return fmt.Sprintf("Could not render %s event", event.Type())
+	}
+
+	return strings.TrimSpace(rendered)
+}
+
+// FormatPercent formats a ratio like 0.05 as "5.00%".
+func FormatPercent(value float64) string {
+	return fmt.Sprintf("%.2f%%", value*100)
+}
@@ -0,0 +1,680 @@
+package templates_test
+
+import (
+	configPkg "main/pkg/config"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/templates"
+	"main/pkg/types"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorActiveFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorActive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"✅ <strong><link> has joined the active set</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorActiveFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorActive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"✅ **<link> has joined the active set** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorActiveFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorActive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"✅ *<link> has joined the active set* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedCommissionFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedCommission{
+		Validator:    &types.Validator{Commission: 0.02},
+		OldValidator: &types.Validator{Commission: 0.01},
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>💰️ <link> has changed its commission</strong>: 1.00% -> 2.00% notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedCommissionFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedCommission{
+		Validator:    &types.Validator{Commission: 0.02},
+		OldValidator: &types.Validator{Commission: 0.01},
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**💰️ <link> has changed its commission**: 1.00% -> 2.00% notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedCommissionFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedCommission{
+		Validator:    &types.Validator{Commission: 0.02},
+		OldValidator: &types.Validator{Commission: 0.01},
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*💰️ <link> has changed its commission*: 1.00% -> 2.00% notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedKeyFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedKey{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>↔️ <link> has changed its signing key</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedKeyFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedKey{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**↔️ <link> has changed its signing key** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedKeyFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedKey{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*↔️ <link> has changed its signing key* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedMonikerFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedMoniker{
+		Validator:    &types.Validator{Moniker: "after"},
+		OldValidator: &types.Validator{Moniker: "before"},
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>✍️ <link> has changed its moniker</strong> (was \"before\") notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedMonikerFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedMoniker{
+		Validator:    &types.Validator{Moniker: "after"},
+		OldValidator: &types.Validator{Moniker: "before"},
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**✍️ <link> has changed its moniker** (was \"before\") notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorChangedMonikerFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorChangedMoniker{
+		Validator:    &types.Validator{Moniker: "after"},
+		OldValidator: &types.Validator{Moniker: "before"},
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*✍️ <link> has changed its moniker* (was \"before\") notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorCreatedFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorCreated{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>💡New validator created: <link></strong>",
+		rendered,
+	)
+}
+
+func TestValidatorCreatedFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorCreated{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**💡New validator created: <link>**",
+		rendered,
+	)
+}
+
+func TestValidatorCreatedFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorCreated{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*💡New validator created: <link>*",
+		rendered,
+	)
+}
+
+func TestValidatorGroupChangedFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorGroupChanged{
+		Validator: &types.Validator{Moniker: "test"},
+		MissedBlocksGroupAfter: &configPkg.MissedBlocksGroup{
+			Start:      0,
+			End:        5,
+			DescStart:  "start1",
+			DescEnd:    "end1",
+			EmojiStart: "emojistart1",
+			EmojiEnd:   "emojiend1",
+		},
+		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{
+			Start:      6,
+			End:        10,
+			DescStart:  "start2",
+			DescEnd:    "end2",
+			EmojiStart: "emojistart2",
+			EmojiEnd:   "emojiend2",
+		},
+	}
+
+	renderData := types.ReportEventRenderData{
+		Notifiers:     "notifier1 notifier2",
+		ValidatorLink: "<link>",
+		TimeToJail:    time.Hour,
+	}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>emojiend1 <link> end1</strong> (1 hour till jail) notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorGroupChangedFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorGroupChanged{
+		Validator: &types.Validator{Moniker: "test"},
+		MissedBlocksGroupAfter: &configPkg.MissedBlocksGroup{
+			Start:      0,
+			End:        5,
+			DescStart:  "start1",
+			DescEnd:    "end1",
+			EmojiStart: "emojistart1",
+			EmojiEnd:   "emojiend1",
+		},
+		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{
+			Start:      6,
+			End:        10,
+			DescStart:  "start2",
+			DescEnd:    "end2",
+			EmojiStart: "emojistart2",
+			EmojiEnd:   "emojiend2",
+		},
+	}
+
+	renderData := types.ReportEventRenderData{
+		Notifiers:     "notifier1 notifier2",
+		ValidatorLink: "<link>",
+		TimeToJail:    time.Hour,
+	}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**emojiend1 <link> end1** (1 hour till jail) notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorGroupChangedFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorGroupChanged{
+		Validator: &types.Validator{Moniker: "test"},
+		MissedBlocksGroupAfter: &configPkg.MissedBlocksGroup{
+			Start:      0,
+			End:        5,
+			DescStart:  "start1",
+			DescEnd:    "end1",
+			EmojiStart: "emojistart1",
+			EmojiEnd:   "emojiend1",
+		},
+		MissedBlocksGroupBefore: &configPkg.MissedBlocksGroup{
+			Start:      6,
+			End:        10,
+			DescStart:  "start2",
+			DescEnd:    "end2",
+			EmojiStart: "emojistart2",
+			EmojiEnd:   "emojiend2",
+		},
+	}
+
+	renderData := types.ReportEventRenderData{
+		Notifiers:     "notifier1 notifier2",
+		ValidatorLink: "<link>",
+		TimeToJail:    time.Hour,
+	}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*emojiend1 <link> end1* (1 hour till jail) notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorInactiveFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorInactive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"😔 <strong><link> has left the active set</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorInactiveFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorInactive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"😔 **<link> has left the active set** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorInactiveFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorInactive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"😔 *<link> has left the active set* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorIncidentReminderFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorIncidentReminder{
+		Validator:         &types.Validator{Moniker: "test"},
+		MissedBlocksGroup: &configPkg.MissedBlocksGroup{DescStart: "is skipping blocks (> 50.0%)"},
+		Duration:          2 * time.Hour,
+		TimeToJail:        3 * time.Hour,
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>⏰ Reminder: <link> is skipping blocks (&gt; 50.0%) for 2 hours (3 hours till jail)</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorIncidentReminderFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorIncidentReminder{
+		Validator:         &types.Validator{Moniker: "test"},
+		MissedBlocksGroup: &configPkg.MissedBlocksGroup{DescStart: "is skipping blocks (> 50.0%)"},
+		Duration:          2 * time.Hour,
+		TimeToJail:        3 * time.Hour,
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**⏰ Reminder: <link> is skipping blocks (> 50.0%) for 2 hours (3 hours till jail)** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorIncidentReminderFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorIncidentReminder{
+		Validator:         &types.Validator{Moniker: "test"},
+		MissedBlocksGroup: &configPkg.MissedBlocksGroup{DescStart: "is skipping blocks (> 50.0%)"},
+		Duration:          2 * time.Hour,
+		TimeToJail:        3 * time.Hour,
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*⏰ Reminder: <link> is skipping blocks (&gt; 50.0%) for 2 hours (3 hours till jail)* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorJailedFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorJailed{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>❌ <link> has been jailed</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorJailedFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorJailed{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**❌ <link> has been jailed** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorJailedFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorJailed{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*❌ <link> has been jailed* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorJoinedSignatoryFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorJoinedSignatory{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>🙋 <link> is now required to sign blocks</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorJoinedSignatoryFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorJoinedSignatory{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**🙋 <link> is now required to sign blocks** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorJoinedSignatoryFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorJoinedSignatory{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*🙋 <link> is now required to sign blocks* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorLeftSignatoryFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorLeftSignatory{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>👋 <link> is now not required to sign blocks</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorLeftSignatoryFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorLeftSignatory{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**👋 <link> is now not required to sign blocks** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorLeftSignatoryFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorLeftSignatory{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*👋 <link> is now not required to sign blocks* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorMaintenanceOverFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorMaintenanceOver{
+		Validator:    &types.Validator{Moniker: "test"},
+		Window:       &types.MaintenanceWindow{StartHeight: 100, EndHeight: 200},
+		MissedBlocks: 5,
+		IsActive:     true,
+		Recovered:    true,
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>✅ Maintenance of <link> (blocks 100 - 200) is over, the validator has recovered (5 missed blocks)</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorMaintenanceOverFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorMaintenanceOver{
+		Validator:    &types.Validator{Moniker: "test"},
+		Window:       &types.MaintenanceWindow{StartHeight: 100, EndHeight: 200},
+		MissedBlocks: 5,
+		IsActive:     true,
+		Recovered:    true,
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**✅ Maintenance of <link> (blocks 100 - 200) is over, the validator has recovered (5 missed blocks)** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorMaintenanceOverFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorMaintenanceOver{
+		Validator:    &types.Validator{Moniker: "test"},
+		Window:       &types.MaintenanceWindow{StartHeight: 100, EndHeight: 200},
+		MissedBlocks: 5,
+		IsActive:     true,
+		Recovered:    true,
+	}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*✅ Maintenance of <link> (blocks 100 - 200) is over, the validator has recovered (5 missed blocks)* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorTombstonedFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorTombstoned{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>💀 <link> has been tombstoned</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorTombstonedFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorTombstoned{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**💀 <link> has been tombstoned** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorTombstonedFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorTombstoned{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*💀 <link> has been tombstoned* notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorUnjailedFormatHTML(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorUnjailed{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"<strong>👌 <link> has been unjailed</strong> notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorUnjailedFormatMarkdown(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorUnjailed{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewDiscordTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"**👌 <link> has been unjailed** notifier1 notifier2",
+		rendered,
+	)
+}
+
+func TestValidatorUnjailedFormatSlack(t *testing.T) {
+	t.Parallel()
+
+	entry := events.ValidatorUnjailed{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{Notifiers: "notifier1 notifier2", ValidatorLink: "<link>"}
+	rendered := templates.RenderEvent(templates.NewSlackTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish), entry, renderData)
+	assert.Equal(
+		t,
+		"*👌 <link> has been unjailed* notifier1 notifier2",
+		rendered,
+	)
+}
@@ -0,0 +1,71 @@
+package templates
+
+import (
+	"fmt"
+	"html/template"
+	"main/pkg/constants"
+	"main/pkg/i18n"
+	"strings"
+	"sync"
+)
+
+var messageEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// TemplatesCache stores the parsed templates. It is shared between the managers
+// in different languages, which can render concurrently, so it is guarded by a mutex.
+type TemplatesCache struct {
+	templates map[string]interface{}
+	mutex     sync.RWMutex
+}
+
+func NewTemplatesCache() *TemplatesCache {
+	return &TemplatesCache{templates: make(map[string]interface{})}
+}
+
+func (c *TemplatesCache) Get(language constants.Language, name string) (interface{}, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	cachedTemplate, ok := c.templates[getTemplateCacheKey(language, name)]
+	return cachedTemplate, ok
+}
+
+func (c *TemplatesCache) Set(language constants.Language, name string, t interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.templates[getTemplateCacheKey(language, name)] = t
+}
+
+// getTemplateCacheKey returns the key to cache the template by, as templates use the
+// functions of the manager language, and managers in different languages share the cache.
+func getTemplateCacheKey(language constants.Language, name string) string {
+	return string(language) + "/" + name
+}
+
+// TranslateHTML translates the message for the managers using html/template: the message
+// and its arguments are escaped, except for the arguments already serialized as HTML, like links.
+func TranslateHTML(localizer *i18n.Localizer, message string, args ...any) template.HTML {
+	translated := messageEscaper.Replace(localizer.Translate(message))
+	if len(args) == 0 {
+		return template.HTML(translated)
+	}
+
+	escapedArgs := make([]any, len(args))
+	for index, arg := range args {
+		switch value := arg.(type) {
+		case template.HTML:
+			escapedArgs[index] = string(value)
+		case string:
+			escapedArgs[index] = template.HTMLEscapeString(value)
+		case fmt.Stringer:
+			escapedArgs[index] = template.HTMLEscapeString(value.String())
+		case error:
+			escapedArgs[index] = template.HTMLEscapeString(value.Error())
+		default:
+			escapedArgs[index] = value
+		}
+	}
+
+	return template.HTML(fmt.Sprintf(translated, escapedArgs...))
+}
@@ -0,0 +1,39 @@
+package templates_test
+
+import (
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/templates"
+	"main/pkg/types"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplatesManagerWithLanguageConcurrent(t *testing.T) {
+	t.Parallel()
+
+	manager := templates.NewTelegramTemplateManager(zerolog.Nop(), "", constants.LanguageEnglish)
+	entry := events.ValidatorActive{Validator: &types.Validator{Moniker: "test"}}
+	renderData := types.ReportEventRenderData{ValidatorLink: "<link>"}
+
+	var wg sync.WaitGroup
+	for _, language := range []constants.Language{
+		constants.LanguageEnglish,
+		constants.LanguageRussian,
+		constants.LanguageSpanish,
+		constants.LanguageChinese,
+	} {
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(language constants.Language) {
+				defer wg.Done()
+				assert.NotEmpty(t, templates.RenderEvent(manager.WithLanguage(language), entry, renderData))
+			}(language)
+		}
+	}
+
+	wg.Wait()
+}
@@ -16,14 +16,30 @@ type Manager interface {
 	SerializeNotifiers(notifiers types.Notifiers) string
 	SerializeNotifier(notifier *types.Notifier) string
 	SerializeEvent(event types.RenderEventItem) string
+	WithLanguage(language constants.Language) Manager
+	Translate(message string, args ...any) string
 }
 
-func NewManager(logger zerolog.Logger, reporterType constants.ReporterName) Manager {
+// NewManager returns the templates manager for the reporter, using the templates
+// from templatesDir instead of the built-in ones if they are overridden there,
+// and rendering them in the language unless another one is chosen with WithLanguage.
+func NewManager(
+	logger zerolog.Logger,
+	reporterType constants.ReporterName,
+	templatesDir string,
+	language constants.Language,
+) Manager {
 	switch reporterType {
 	case constants.TelegramReporterName:
-		return NewTelegramTemplateManager(logger)
+		return NewTelegramTemplateManager(logger, templatesDir, language)
 	case constants.DiscordReporterName:
-		return NewDiscordTemplateManager(logger)
+		return NewDiscordTemplateManager(logger, templatesDir, language)
+	case constants.SlackReporterName:
+		return NewSlackTemplateManager(logger, templatesDir, language)
+	case constants.MatrixReporterName:
+		return NewMatrixTemplateManager(logger, templatesDir, language)
+	case constants.EmailReporterName:
+		return NewEmailTemplateManager(logger, templatesDir, language)
 	case constants.TestReporterName:
 		fallthrough
 	default:
@@ -0,0 +1,175 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/i18n"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type MatrixTemplateManager struct {
+	Logger       zerolog.Logger
+	Templates    *TemplatesCache
+	TemplatesDir string
+	Language     constants.Language
+}
+
+func NewMatrixTemplateManager(
+	logger zerolog.Logger,
+	templatesDir string,
+	language constants.Language,
+) *MatrixTemplateManager {
+	return &MatrixTemplateManager{
+		Logger: logger.With().
+			Str("component", "templates_manager").
+			Str("reporter", "matrix").
+			Logger(),
+		TemplatesDir: templatesDir,
+		Language:     language,
+		Templates:    NewTemplatesCache(),
+	}
+}
+
+func (m *MatrixTemplateManager) GetHTMLTemplate(name string) (*template.Template, error) {
+	if cachedTemplate, ok := m.Templates.Get(m.Language, name); ok {
+		m.Logger.Trace().Str("type", name).Msg("Using cached template")
+		if convertedTemplate, ok := cachedTemplate.(*template.Template); !ok {
+			return nil, errors.New("error converting template")
+		} else {
+			return convertedTemplate, nil
+		}
+	}
+
+	m.Logger.Trace().Str("type", name).Msg("Loading template")
+
+	allSerializers := map[string]any{
+		"T":              m.TranslateHTML,
+		"FormatPercent":  FormatPercent,
+		"FormatDuration": m.FormatDuration,
+		"SerializeLink":  m.SerializeLink,
+		"SerializeDate":  m.SerializeDate,
+		"SerializeNotifier": func(notifier *types.Notifier) template.HTML {
+			return template.HTML(m.SerializeNotifier(notifier))
+		},
+		"SerializeNotifiers": func(notifiers types.Notifiers) template.HTML {
+			return template.HTML(m.SerializeNotifiers(notifiers))
+		},
+	}
+
+	templatePath := "matrix/" + name + ".html"
+
+	t, err := template.New(path.Base(templatePath)).
+		Funcs(allSerializers).
+		ParseFS(GetTemplatesFS(m.TemplatesDir, templatePath), templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Templates.Set(m.Language, name, t)
+
+	return t, nil
+}
+
+func (m *MatrixTemplateManager) ParseTemplateFile(filename string) error {
+	_, err := m.GetHTMLTemplate(strings.TrimSuffix(filename, ".html"))
+	return err
+}
+
+func (m *MatrixTemplateManager) Render(templateName string, data interface{}) (string, error) {
+	templateToRender, err := m.GetHTMLTemplate(templateName)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("type", templateName).Msg("Error loading template")
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	err = templateToRender.Execute(&buffer, data)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("type", templateName).Msg("Error rendering template")
+		return "", err
+	}
+
+	return buffer.String(), err
+}
+
+// WithLanguage returns the manager rendering templates in the language,
+// or this manager if the language is not set.
+func (m *MatrixTemplateManager) WithLanguage(language constants.Language) Manager {
+	if language == "" || language == m.Language {
+		return m
+	}
+
+	localized := *m
+	localized.Language = language
+	return &localized
+}
+
+func (m *MatrixTemplateManager) Translate(message string, args ...any) string {
+	return i18n.GetLocalizer(m.Language).Translate(message, args...)
+}
+
+func (m *MatrixTemplateManager) TranslateHTML(message string, args ...any) template.HTML {
+	return TranslateHTML(i18n.GetLocalizer(m.Language), message, args...)
+}
+
+func (m *MatrixTemplateManager) FormatDuration(duration time.Duration) string {
+	return i18n.GetLocalizer(m.Language).FormatDuration(duration)
+}
+
+func (m *MatrixTemplateManager) SerializeDate(date time.Time) string {
+	return i18n.GetLocalizer(m.Language).FormatDate(date)
+}
+
+func (m *MatrixTemplateManager) SerializeLink(link types.Link) template.HTML {
+	if link.Href == "" {
+		return template.HTML(html.EscapeString(link.Text))
+	}
+
+	return template.HTML(fmt.Sprintf("<a href=\"%s\">%s</a>", link.Href, html.EscapeString(link.Text)))
+}
+
+func (m *MatrixTemplateManager) SerializeNotifiers(notifiers types.Notifiers) string {
+	notifiersNormalized := utils.Map(notifiers, m.SerializeNotifier)
+
+	return strings.Join(notifiersNormalized, " ")
+}
+
+func (m *MatrixTemplateManager) SerializeNotifier(notifier *types.Notifier) string {
+	return fmt.Sprintf(
+		"<a href=\"https://matrix.to/#/%s\">%s</a>",
+		notifier.UserID,
+		html.EscapeString(notifier.UserName),
+	)
+}
+
+func (m *MatrixTemplateManager) SerializeEvent(event types.RenderEventItem) string {
+	renderData := types.ReportEventRenderData{
+		Notifiers:     template.HTML(m.SerializeNotifiers(event.Notifiers)),
+		ValidatorLink: m.SerializeLink(event.ValidatorLink),
+	}
+
+	switch entry := event.Event.(type) {
+	case events.ValidatorGroupChanged:
+		if entry.IsIncreasing() {
+			renderData.TimeToJail = event.TimeToJail
+		}
+	}
+
+	rendered := RenderEvent(m, event.Event, renderData)
+	if ackedBy := event.GetAckedBy(); ackedBy != "" {
+		rendered = fmt.Sprintf("%s (ack'd by %s)", strings.TrimSpace(rendered), html.EscapeString(ackedBy))
+	}
+
+	return rendered
+}
@@ -0,0 +1,104 @@
+package templates
+
+import (
+	"fmt"
+	"io/fs"
+	"main/pkg/constants"
+	"main/templates"
+	"os"
+	"strings"
+
+	configPkg "main/pkg/config"
+
+	"github.com/rs/zerolog"
+)
+
+// GetTemplatesFS returns the filesystem to load the template at the given path from:
+// the overrides directory if it has this template, or the embedded templates otherwise.
+func GetTemplatesFS(templatesDir string, templatePath string) fs.FS {
+	if HasOverride(templatesDir, templatePath) {
+		return os.DirFS(templatesDir)
+	}
+
+	return templates.TemplatesFs
+}
+
+func HasOverride(templatesDir string, templatePath string) bool {
+	if templatesDir == "" {
+		return false
+	}
+
+	info, err := fs.Stat(os.DirFS(templatesDir), templatePath)
+	return err == nil && !info.IsDir()
+}
+
+// ValidateOverrides checks that every template in the overrides directory overrides
+// an existing built-in template, and that it can be parsed.
+func ValidateOverrides(templatesDir string) error {
+	info, err := os.Stat(templatesDir)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", templatesDir)
+	}
+
+	logger := zerolog.Nop()
+	managers := map[string]overridableManager{
+		"telegram": NewTelegramTemplateManager(logger, templatesDir, constants.LanguageEnglish),
+		"discord":  NewDiscordTemplateManager(logger, templatesDir, constants.LanguageEnglish),
+		"slack":    NewSlackTemplateManager(logger, templatesDir, constants.LanguageEnglish),
+		"matrix":   NewMatrixTemplateManager(logger, templatesDir, constants.LanguageEnglish),
+		"email":    NewEmailTemplateManager(logger, templatesDir, constants.LanguageEnglish),
+	}
+
+	return fs.WalkDir(os.DirFS(templatesDir), ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+
+		reporter, templateFile, _ := strings.Cut(filePath, "/")
+		manager, ok := managers[reporter]
+		if !ok {
+			return fmt.Errorf("%s: unknown reporter %s", filePath, reporter)
+		}
+
+		if info, err := fs.Stat(templates.TemplatesFs, filePath); err != nil || info.IsDir() {
+			return fmt.Errorf("%s: there is no such template to override", filePath)
+		}
+
+		if err := manager.ParseTemplateFile(templateFile); err != nil {
+			return fmt.Errorf("%s: %s", filePath, err)
+		}
+
+		return nil
+	})
+}
+
+// ValidateConfigOverrides validates the templates overrides directories of all chains.
+func ValidateConfigOverrides(config *configPkg.Config) error {
+	validated := make(map[string]bool)
+
+	for _, chainConfig := range config.ChainConfigs {
+		if chainConfig.TemplatesDir == "" || validated[chainConfig.TemplatesDir] {
+			continue
+		}
+
+		if err := ValidateOverrides(chainConfig.TemplatesDir); err != nil {
+			return fmt.Errorf("error in templates of chain %s: %s", chainConfig.Name, err)
+		}
+
+		validated[chainConfig.TemplatesDir] = true
+	}
+
+	return nil
+}
+
+type overridableManager interface {
+	ParseTemplateFile(filename string) error
+}
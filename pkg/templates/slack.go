@@ -0,0 +1,173 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmlTemplate "html/template"
+	"main/pkg/constants"
+	"main/pkg/events"
+	"main/pkg/i18n"
+	"main/pkg/types"
+	"main/pkg/utils"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type SlackTemplateManager struct {
+	Logger       zerolog.Logger
+	Templates    *TemplatesCache
+	TemplatesDir string
+	Language     constants.Language
+}
+
+func NewSlackTemplateManager(
+	logger zerolog.Logger,
+	templatesDir string,
+	language constants.Language,
+) *SlackTemplateManager {
+	return &SlackTemplateManager{
+		Logger: logger.With().
+			Str("component", "templates_manager").
+			Str("reporter", "slack").
+			Logger(),
+		TemplatesDir: templatesDir,
+		Language:     language,
+		Templates:    NewTemplatesCache(),
+	}
+}
+
+func (m *SlackTemplateManager) GetTemplate(name string) (*template.Template, error) {
+	if cachedTemplate, ok := m.Templates.Get(m.Language, name); ok {
+		m.Logger.Trace().Str("type", name).Msg("Using cached template")
+		if convertedTemplate, ok := cachedTemplate.(*template.Template); !ok {
+			return nil, errors.New("error converting template")
+		} else {
+			return convertedTemplate, nil
+		}
+	}
+
+	allSerializers := map[string]any{
+		"T":                         m.Translate,
+		"FormatPercent":             FormatPercent,
+		"FormatDuration":            m.FormatDuration,
+		"EscapeSlack":               utils.EscapeSlack,
+		"SerializeLink":             m.SerializeLink,
+		"SerializeDate":             m.SerializeDate,
+		"SerializeNotifier":         m.SerializeNotifier,
+		"SerializeNotifiers":        m.SerializeNotifiers,
+		"SerializeNotifiersNoLinks": m.SerializeNotifiersNoLinks,
+	}
+
+	m.Logger.Trace().Str("type", name).Msg("Loading template")
+
+	templatePath := "slack/" + name + ".md"
+
+	t, err := template.New(path.Base(templatePath)).
+		Funcs(allSerializers).
+		ParseFS(GetTemplatesFS(m.TemplatesDir, templatePath), templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Templates.Set(m.Language, name, t)
+
+	return t, nil
+}
+
+func (m *SlackTemplateManager) ParseTemplateFile(filename string) error {
+	_, err := m.GetTemplate(strings.TrimSuffix(filename, ".md"))
+	return err
+}
+
+func (m *SlackTemplateManager) Render(templateName string, data interface{}) (string, error) {
+	templateToRender, err := m.GetTemplate(templateName)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("type", templateName).Msg("Error loading template")
+		return "", err
+	}
+
+	var buffer bytes.Buffer
+	err = templateToRender.Execute(&buffer, data)
+	if err != nil {
+		m.Logger.Error().Err(err).Str("type", templateName).Msg("Error rendering template")
+		return "", err
+	}
+
+	return buffer.String(), err
+}
+
+func (m *SlackTemplateManager) SerializeLink(link types.Link) htmlTemplate.HTML {
+	if link.Href == "" {
+		return htmlTemplate.HTML(utils.EscapeSlack(link.Text))
+	}
+
+	return htmlTemplate.HTML(fmt.Sprintf("<%s|%s>", link.Href, utils.EscapeSlack(link.Text)))
+}
+
+func (m *SlackTemplateManager) SerializeNotifiers(notifiers types.Notifiers) string {
+	notifiersNormalized := utils.Map(notifiers, m.SerializeNotifier)
+
+	return strings.Join(notifiersNormalized, " ")
+}
+
+func (m *SlackTemplateManager) SerializeNotifiersNoLinks(notifiers types.Notifiers) string {
+	notifiersNormalized := utils.Map(notifiers, func(n *types.Notifier) string {
+		return "`@" + utils.EscapeSlack(n.UserName) + "`"
+	})
+
+	return strings.Join(notifiersNormalized, " ")
+}
+
+func (m *SlackTemplateManager) SerializeNotifier(notifier *types.Notifier) string {
+	return fmt.Sprintf("<@%s>", notifier.UserID)
+}
+
+// WithLanguage returns the manager rendering templates in the language,
+// or this manager if the language is not set.
+func (m *SlackTemplateManager) WithLanguage(language constants.Language) Manager {
+	if language == "" || language == m.Language {
+		return m
+	}
+
+	localized := *m
+	localized.Language = language
+	return &localized
+}
+
+func (m *SlackTemplateManager) Translate(message string, args ...any) string {
+	return i18n.GetLocalizer(m.Language).Translate(message, args...)
+}
+
+func (m *SlackTemplateManager) FormatDuration(duration time.Duration) string {
+	return i18n.GetLocalizer(m.Language).FormatDuration(duration)
+}
+
+func (m *SlackTemplateManager) SerializeDate(date time.Time) string {
+	return i18n.GetLocalizer(m.Language).FormatDate(date)
+}
+
+func (m *SlackTemplateManager) SerializeEvent(event types.RenderEventItem) string {
+	renderData := types.ReportEventRenderData{
+		Notifiers:     htmlTemplate.HTML(m.SerializeNotifiers(event.Notifiers)),
+		ValidatorLink: m.SerializeLink(event.ValidatorLink),
+	}
+
+	switch entry := event.Event.(type) {
+	case events.ValidatorGroupChanged:
+		if entry.IsIncreasing() {
+			renderData.TimeToJail = event.TimeToJail
+		}
+	}
+
+	rendered := RenderEvent(m, event.Event, renderData)
+	if ackedBy := event.GetAckedBy(); ackedBy != "" {
+		rendered = fmt.Sprintf("%s (ack'd by %s)", strings.TrimSpace(rendered), utils.EscapeSlack(ackedBy))
+	}
+
+	return rendered
+}
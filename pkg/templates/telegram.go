@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"html"
 	"html/template"
 	"main/pkg/constants"
 	"main/pkg/events"
+	"main/pkg/i18n"
 	"main/pkg/types"
 	"main/pkg/utils"
-	"main/templates"
+	"path"
 	"strings"
 	"time"
 
@@ -17,22 +19,30 @@ import (
 )
 
 type TelegramTemplateManager struct {
-	Logger    zerolog.Logger
-	Templates map[string]interface{}
+	Logger       zerolog.Logger
+	Templates    *TemplatesCache
+	TemplatesDir string
+	Language     constants.Language
 }
 
-func NewTelegramTemplateManager(logger zerolog.Logger) *TelegramTemplateManager {
+func NewTelegramTemplateManager(
+	logger zerolog.Logger,
+	templatesDir string,
+	language constants.Language,
+) *TelegramTemplateManager {
 	return &TelegramTemplateManager{
 		Logger: logger.With().
 			Str("component", "templates_manager").
 			Str("reporter", "telegram").
 			Logger(),
-		Templates: make(map[string]interface{}),
+		TemplatesDir: templatesDir,
+		Language:     language,
+		Templates:    NewTemplatesCache(),
 	}
 }
 
 func (m *TelegramTemplateManager) GetHTMLTemplate(name string) (*template.Template, error) {
-	if cachedTemplate, ok := m.Templates[name]; ok {
+	if cachedTemplate, ok := m.Templates.Get(m.Language, name); ok {
 		m.Logger.Trace().Str("type", name).Msg("Using cached template")
 		if convertedTemplate, ok := cachedTemplate.(*template.Template); !ok {
 			return nil, errors.New("error converting template")
@@ -44,24 +54,34 @@ func (m *TelegramTemplateManager) GetHTMLTemplate(name string) (*template.Templa
 	m.Logger.Trace().Str("type", name).Msg("Loading template")
 
 	allSerializers := map[string]any{
+		"T":                  m.TranslateHTML,
+		"FormatPercent":      FormatPercent,
+		"FormatDuration":     m.FormatDuration,
 		"SerializeLink":      m.SerializeLink,
 		"SerializeDate":      m.SerializeDate,
 		"SerializeNotifier":  m.SerializeNotifier,
 		"SerializeNotifiers": m.SerializeNotifiers,
 	}
 
-	t, err := template.New(name+".html").
+	templatePath := "telegram/" + name + ".html"
+
+	t, err := template.New(path.Base(templatePath)).
 		Funcs(allSerializers).
-		ParseFS(templates.TemplatesFs, "telegram/"+name+".html")
+		ParseFS(GetTemplatesFS(m.TemplatesDir, templatePath), templatePath)
 	if err != nil {
 		return nil, err
 	}
 
-	m.Templates[name] = t
+	m.Templates.Set(m.Language, name, t)
 
 	return t, nil
 }
 
+func (m *TelegramTemplateManager) ParseTemplateFile(filename string) error {
+	_, err := m.GetHTMLTemplate(strings.TrimSuffix(filename, ".html"))
+	return err
+}
+
 func (m *TelegramTemplateManager) Render(templateName string, data interface{}) (string, error) {
 	templateToRender, err := m.GetHTMLTemplate(templateName)
 	if err != nil {
@@ -79,8 +99,32 @@ func (m *TelegramTemplateManager) Render(templateName string, data interface{})
 	return buffer.String(), err
 }
 
+// WithLanguage returns the manager rendering templates in the language,
+// or this manager if the language is not set.
+func (m *TelegramTemplateManager) WithLanguage(language constants.Language) Manager {
+	if language == "" || language == m.Language {
+		return m
+	}
+
+	localized := *m
+	localized.Language = language
+	return &localized
+}
+
+func (m *TelegramTemplateManager) Translate(message string, args ...any) string {
+	return i18n.GetLocalizer(m.Language).Translate(message, args...)
+}
+
+func (m *TelegramTemplateManager) TranslateHTML(message string, args ...any) template.HTML {
+	return TranslateHTML(i18n.GetLocalizer(m.Language), message, args...)
+}
+
+func (m *TelegramTemplateManager) FormatDuration(duration time.Duration) string {
+	return i18n.GetLocalizer(m.Language).FormatDuration(duration)
+}
+
 func (m *TelegramTemplateManager) SerializeDate(date time.Time) string {
-	return date.Format(time.RFC822)
+	return i18n.GetLocalizer(m.Language).FormatDate(date)
 }
 
 func (m *TelegramTemplateManager) SerializeLink(link types.Link) template.HTML {
@@ -107,16 +151,21 @@ func (m *TelegramTemplateManager) SerializeNotifier(notifier *types.Notifier) st
 
 func (m *TelegramTemplateManager) SerializeEvent(event types.RenderEventItem) string {
 	renderData := types.ReportEventRenderData{
-		Notifiers:     m.SerializeNotifiers(event.Notifiers),
+		Notifiers:     template.HTML(m.SerializeNotifiers(event.Notifiers)),
 		ValidatorLink: m.SerializeLink(event.ValidatorLink),
 	}
 
 	switch entry := event.Event.(type) {
 	case events.ValidatorGroupChanged:
 		if entry.IsIncreasing() {
-			renderData.TimeToJail = fmt.Sprintf(" (%s till jail)", utils.FormatDuration(event.TimeToJail))
+			renderData.TimeToJail = event.TimeToJail
 		}
 	}
 
-	return event.Event.Render(constants.FormatTypeHTML, renderData)
+	rendered := RenderEvent(m, event.Event, renderData)
+	if ackedBy := event.GetAckedBy(); ackedBy != "" {
+		rendered = fmt.Sprintf("%s (ack'd by %s)", strings.TrimSpace(rendered), html.EscapeString(ackedBy))
+	}
+
+	return rendered
 }
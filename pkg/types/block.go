@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"main/pkg/constants"
 	"time"
 )
 
@@ -20,3 +21,9 @@ func (b *Block) Hash() string {
 func (b *Block) SetValidators(validators map[string]bool) {
 	b.Validators = validators
 }
+
+// BlockSignature is how a validator took part in a block, used to draw its blocks chart.
+type BlockSignature struct {
+	Height int64
+	Status constants.BlockStatus
+}
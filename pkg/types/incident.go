@@ -0,0 +1,41 @@
+package types
+
+import "time"
+
+// IncidentOutcome is how an incident was closed.
+type IncidentOutcome string
+
+const (
+	// IncidentRecovered is for the incidents closed by the validator getting unjailed
+	// or going back below the incidents threshold group.
+	IncidentRecovered IncidentOutcome = "recovered"
+	// IncidentTombstoned is for the incidents closed by the validator getting tombstoned,
+	// which it cannot recover from.
+	IncidentTombstoned IncidentOutcome = "tombstoned"
+)
+
+// Incident is a period during which a validator is jailed or stays in one
+// of the missed blocks groups starting from the configured threshold.
+type Incident struct {
+	ID              int64
+	OperatorAddress string
+	StartedAt       time.Time
+	AckedBy         string
+	AckedAt         time.Time
+	// when the last reminder about it was sent, zero if none was sent yet
+	RemindedAt time.Time
+}
+
+func (i *Incident) IsAcked() bool {
+	return i.AckedBy != ""
+}
+
+// GetLastRemindedAt returns when the last reminder about the incident was sent,
+// or when it started if there was none yet.
+func (i *Incident) GetLastRemindedAt() time.Time {
+	if i.RemindedAt.IsZero() {
+		return i.StartedAt
+	}
+
+	return i.RemindedAt
+}
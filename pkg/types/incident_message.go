@@ -0,0 +1,45 @@
+package types
+
+import (
+	"main/pkg/constants"
+	"strings"
+)
+
+// IncidentMessage is a message a reporter keeps editing while the validator's incident
+// is ongoing, showing each step of it, like missed blocks counters or getting jailed.
+type IncidentMessage struct {
+	IncidentID      int64
+	OperatorAddress string
+	Reporter        constants.ReporterName
+	Destination     string
+	MessageID       string
+	ThreadID        string
+	Progression     []string
+	Resolved        bool
+	// how the incident was closed, set along with Resolved
+	Outcome IncidentOutcome
+}
+
+func (m *IncidentMessage) IsNew() bool {
+	return m.MessageID == ""
+}
+
+// GetClosingLine returns the emoji and the line, to be translated, showing how the incident was closed.
+func (m *IncidentMessage) GetClosingLine() (string, string) {
+	if m.Outcome == IncidentTombstoned {
+		return "💀", "Incident is closed, the validator is tombstoned"
+	}
+
+	return "✅", "Incident is over"
+}
+
+// SerializeProgression returns the incident steps, translating the ones that are words,
+// like "jailed", while the missed blocks counters are kept as they are.
+func (m *IncidentMessage) SerializeProgression(translate func(message string, args ...any) string) string {
+	steps := make([]string, len(m.Progression))
+	for index, step := range m.Progression {
+		steps[index] = translate(step)
+	}
+
+	return strings.Join(steps, " → ")
+}
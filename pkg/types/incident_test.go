@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentGetLastRemindedAt(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Unix(1000, 0)
+	incident := &Incident{StartedAt: startedAt}
+	require.Equal(t, startedAt, incident.GetLastRemindedAt())
+
+	incident.RemindedAt = time.Unix(2000, 0)
+	require.Equal(t, time.Unix(2000, 0), incident.GetLastRemindedAt())
+}
+
+func TestIncidentMessageSerializeProgression(t *testing.T) {
+	t.Parallel()
+
+	message := &IncidentMessage{Progression: []string{"🟡 150", "jailed"}}
+	translate := func(message string, args ...any) string {
+		if message == "jailed" {
+			return "encarcelado"
+		}
+
+		return message
+	}
+
+	require.Equal(t, "🟡 150 → encarcelado", message.SerializeProgression(translate))
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maintenanceWindowTimeLayout = "2006-01-02 15:04 MST"
+
+// MaintenanceWindow is a period declared for a validator, either by time or by blocks
+// heights, during which its missed blocks group changes are not reported.
+type MaintenanceWindow struct {
+	OperatorAddress string
+	StartTime       time.Time
+	EndTime         time.Time
+	StartHeight     int64
+	EndHeight       int64
+	CreatedBy       string
+}
+
+// ParseMaintenanceWindow parses the window period from command arguments, which is either
+// a duration with an optional RFC3339 start time, like "2h 2024-01-01T10:00:00Z",
+// or a blocks heights range, like "100000-100500".
+func ParseMaintenanceWindow(
+	operatorAddress string,
+	args []string,
+	now time.Time,
+) (*MaintenanceWindow, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return nil, fmt.Errorf("expected a duration with an optional start time, or a blocks range")
+	}
+
+	window := &MaintenanceWindow{OperatorAddress: operatorAddress}
+
+	if start, end, found := strings.Cut(args[0], "-"); found {
+		if len(args) > 1 {
+			return nil, fmt.Errorf("start time cannot be specified for a blocks range")
+		}
+
+		startHeight, err := strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start height %s", start)
+		}
+
+		endHeight, err := strconv.ParseInt(end, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end height %s", end)
+		}
+
+		window.StartHeight = startHeight
+		window.EndHeight = endHeight
+		return window, window.Validate()
+	}
+
+	duration, err := utils.ParseDuration(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration %s", args[0])
+	}
+
+	window.StartTime = now
+	if len(args) > 1 {
+		if window.StartTime, err = time.Parse(time.RFC3339, args[1]); err != nil {
+			return nil, fmt.Errorf("invalid start time %s, expected RFC3339 like 2006-01-02T15:04:05Z", args[1])
+		}
+	}
+
+	window.EndTime = window.StartTime.Add(duration)
+	return window, window.Validate()
+}
+
+func (w *MaintenanceWindow) Validate() error {
+	if w.IsHeightBased() {
+		if w.StartHeight <= 0 || w.EndHeight < w.StartHeight {
+			return fmt.Errorf("invalid blocks range %d-%d", w.StartHeight, w.EndHeight)
+		}
+
+		return nil
+	}
+
+	if w.StartTime.IsZero() || !w.EndTime.After(w.StartTime) {
+		return fmt.Errorf("window end should be after its start")
+	}
+
+	return nil
+}
+
+func (w *MaintenanceWindow) IsHeightBased() bool {
+	return w.EndHeight > 0
+}
+
+func (w *MaintenanceWindow) IsActive(now time.Time, height int64) bool {
+	if w.IsHeightBased() {
+		return height >= w.StartHeight && height <= w.EndHeight
+	}
+
+	return !now.Before(w.StartTime) && now.Before(w.EndTime)
+}
+
+func (w *MaintenanceWindow) IsOver(now time.Time, height int64) bool {
+	if w.IsHeightBased() {
+		return height > w.EndHeight
+	}
+
+	return !now.Before(w.EndTime)
+}
+
+func (w *MaintenanceWindow) String() string {
+	if w.IsHeightBased() {
+		return fmt.Sprintf("blocks %d - %d", w.StartHeight, w.EndHeight)
+	}
+
+	return fmt.Sprintf(
+		"%s - %s",
+		w.StartTime.UTC().Format(maintenanceWindowTimeLayout),
+		w.EndTime.UTC().Format(maintenanceWindowTimeLayout),
+	)
+}
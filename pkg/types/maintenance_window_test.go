@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMaintenanceWindowInvalid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, args := range [][]string{
+		{},
+		{"2h", "2024-01-01T10:00:00Z", "extra"},
+		{"invalid"},
+		{"2h", "invalid"},
+		{"0s"},
+		{"100-200", "2024-01-01T10:00:00Z"},
+		{"abc-200"},
+		{"100-abc"},
+		{"200-100"},
+	} {
+		_, err := ParseMaintenanceWindow("address", args, now)
+		require.Error(t, err, args)
+	}
+}
+
+func TestParseMaintenanceWindowDuration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	window, err := ParseMaintenanceWindow("address", []string{"2h"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "address", window.OperatorAddress)
+	assert.False(t, window.IsHeightBased())
+	assert.Equal(t, now, window.StartTime)
+	assert.Equal(t, now.Add(2*time.Hour), window.EndTime)
+	assert.Equal(t, "2024-01-01 10:00 UTC - 2024-01-01 12:00 UTC", window.String())
+
+	window, err = ParseMaintenanceWindow("address", []string{"1d", "2024-01-02T00:00:00Z"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-02 00:00 UTC - 2024-01-03 00:00 UTC", window.String())
+}
+
+func TestParseMaintenanceWindowHeights(t *testing.T) {
+	t.Parallel()
+
+	window, err := ParseMaintenanceWindow("address", []string{"100-200"}, time.Now())
+	require.NoError(t, err)
+	assert.True(t, window.IsHeightBased())
+	assert.Equal(t, int64(100), window.StartHeight)
+	assert.Equal(t, int64(200), window.EndHeight)
+	assert.Equal(t, "blocks 100 - 200", window.String())
+}
+
+func TestMaintenanceWindowIsActiveByTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	window := &MaintenanceWindow{StartTime: now, EndTime: now.Add(time.Hour)}
+
+	assert.False(t, window.IsActive(now.Add(-time.Minute), 0))
+	assert.True(t, window.IsActive(now, 0))
+	assert.False(t, window.IsOver(now.Add(30*time.Minute), 0))
+	assert.False(t, window.IsActive(now.Add(time.Hour), 0))
+	assert.True(t, window.IsOver(now.Add(time.Hour), 0))
+}
+
+func TestMaintenanceWindowIsActiveByHeight(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	window := &MaintenanceWindow{StartHeight: 100, EndHeight: 200}
+
+	assert.False(t, window.IsActive(now, 99))
+	assert.True(t, window.IsActive(now, 100))
+	assert.True(t, window.IsActive(now, 200))
+	assert.False(t, window.IsOver(now, 200))
+	assert.False(t, window.IsActive(now, 201))
+	assert.True(t, window.IsOver(now, 201))
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"main/pkg/constants"
+	"main/pkg/utils"
+	"strconv"
+	"strings"
+)
+
+// MissedBlocksEvent is implemented by the events caused by a validator missing blocks,
+// so they can be filtered by the missed blocks percentage.
+type MissedBlocksEvent interface {
+	GetMissedBlocks() int64
+}
+
+type NotifierFilters struct {
+	MinMissedPercent float64
+	Events           []constants.EventName
+}
+
+func ParseEventNames(value string) ([]constants.EventName, error) {
+	eventNames := make([]constants.EventName, 0)
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		eventName, found := utils.Find(constants.GetEventNames(), func(eventName constants.EventName) bool {
+			return strings.EqualFold(string(eventName), name) || strings.EqualFold(GetEventShortName(eventName), name)
+		})
+		if !found {
+			return nil, fmt.Errorf("unknown event %s", name)
+		}
+
+		if !utils.Contains(eventNames, eventName) {
+			eventNames = append(eventNames, eventName)
+		}
+	}
+
+	if len(eventNames) == 0 {
+		return nil, fmt.Errorf("no events specified")
+	}
+
+	return eventNames, nil
+}
+
+// GetEventShortName returns the event name without the "Validator" prefix
+// in lower case, like "jailed" or "groupchanged".
+func GetEventShortName(eventName constants.EventName) string {
+	return strings.ToLower(strings.TrimPrefix(string(eventName), "Validator"))
+}
+
+// ParseNotifierFilters parses the filters from command arguments,
+// like "--min 10 --events jailed,tombstoned".
+func ParseNotifierFilters(args []string) (NotifierFilters, error) {
+	filters := NotifierFilters{}
+
+	for index := 0; index < len(args); index += 2 {
+		if index+1 >= len(args) {
+			return filters, fmt.Errorf("no value specified for %s", args[index])
+		}
+
+		value := args[index+1]
+
+		switch args[index] {
+		case "--min":
+			minMissedPercent, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if err != nil || minMissedPercent < 0 || minMissedPercent > 100 {
+				return filters, fmt.Errorf("invalid missed blocks percentage %s, expected a number from 0 to 100", value)
+			}
+
+			filters.MinMissedPercent = minMissedPercent
+		case "--events":
+			eventNames, err := ParseEventNames(value)
+			if err != nil {
+				return filters, err
+			}
+
+			filters.Events = eventNames
+		default:
+			return filters, fmt.Errorf("unknown argument %s", args[index])
+		}
+	}
+
+	return filters, nil
+}
+
+func (f NotifierFilters) IsEmpty() bool {
+	return f.MinMissedPercent == 0 && len(f.Events) == 0
+}
+
+func (f NotifierFilters) Matches(event ReportEvent, blocksWindow int64) bool {
+	if len(f.Events) > 0 && !utils.Contains(f.Events, event.Type()) {
+		return false
+	}
+
+	if f.MinMissedPercent <= 0 || blocksWindow <= 0 {
+		return true
+	}
+
+	missedBlocksEvent, ok := event.(MissedBlocksEvent)
+	if !ok {
+		return true
+	}
+
+	return float64(missedBlocksEvent.GetMissedBlocks())/float64(blocksWindow)*100 >= f.MinMissedPercent
+}
+
+func (f NotifierFilters) String() string {
+	if f.IsEmpty() {
+		return "all events"
+	}
+
+	parts := make([]string, 0)
+
+	if f.MinMissedPercent > 0 {
+		parts = append(parts, fmt.Sprintf("missed blocks >= %.2f%%", f.MinMissedPercent))
+	}
+
+	if len(f.Events) > 0 {
+		parts = append(parts, "events: "+strings.Join(utils.Map(f.Events, GetEventShortName), ", "))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func (f NotifierFilters) SerializeEvents() string {
+	return strings.Join(utils.Map(f.Events, func(eventName constants.EventName) string {
+		return string(eventName)
+	}), ",")
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"main/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMissedBlocksEvent struct {
+	testReportEvent
+	missedBlocks int64
+}
+
+func (e testMissedBlocksEvent) Type() constants.EventName {
+	return constants.EventValidatorGroupChanged
+}
+
+func (e testMissedBlocksEvent) GetMissedBlocks() int64 {
+	return e.missedBlocks
+}
+
+func TestParseEventNamesInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseEventNames("jailed,unknown")
+	require.Error(t, err)
+
+	_, err = ParseEventNames(",")
+	require.Error(t, err)
+}
+
+func TestParseEventNamesValid(t *testing.T) {
+	t.Parallel()
+
+	eventNames, err := ParseEventNames("jailed, ValidatorTombstoned,jailed,GroupChanged")
+	require.NoError(t, err)
+	assert.Equal(t, []constants.EventName{
+		constants.EventValidatorJailed,
+		constants.EventValidatorTombstoned,
+		constants.EventValidatorGroupChanged,
+	}, eventNames)
+}
+
+func TestParseNotifierFiltersInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseNotifierFilters([]string{"--min"})
+	require.Error(t, err)
+
+	_, err = ParseNotifierFilters([]string{"--min", "abc"})
+	require.Error(t, err)
+
+	_, err = ParseNotifierFilters([]string{"--min", "101"})
+	require.Error(t, err)
+
+	_, err = ParseNotifierFilters([]string{"--events", "unknown"})
+	require.Error(t, err)
+
+	_, err = ParseNotifierFilters([]string{"--unknown", "value"})
+	require.Error(t, err)
+}
+
+func TestParseNotifierFiltersValid(t *testing.T) {
+	t.Parallel()
+
+	filters, err := ParseNotifierFilters([]string{})
+	require.NoError(t, err)
+	assert.True(t, filters.IsEmpty())
+	assert.Equal(t, "all events", filters.String())
+
+	filters, err = ParseNotifierFilters([]string{"--min", "10%", "--events", "jailed,tombstoned"})
+	require.NoError(t, err)
+	assert.InDelta(t, 10, filters.MinMissedPercent, 0.001)
+	assert.Equal(t, []constants.EventName{
+		constants.EventValidatorJailed,
+		constants.EventValidatorTombstoned,
+	}, filters.Events)
+	assert.Equal(t, "missed blocks >= 10.00%, events: jailed, tombstoned", filters.String())
+	assert.Equal(t, "ValidatorJailed,ValidatorTombstoned", filters.SerializeEvents())
+}
+
+func TestNotifierFiltersMatchesEvents(t *testing.T) {
+	t.Parallel()
+
+	filters := NotifierFilters{Events: []constants.EventName{constants.EventValidatorJailed}}
+	assert.True(t, filters.Matches(testReportEvent{}, 10000))
+	assert.False(t, filters.Matches(testMissedBlocksEvent{missedBlocks: 5000}, 10000))
+	assert.True(t, NotifierFilters{}.Matches(testMissedBlocksEvent{missedBlocks: 5000}, 10000))
+}
+
+func TestNotifierFiltersMatchesMinMissedPercent(t *testing.T) {
+	t.Parallel()
+
+	filters := NotifierFilters{MinMissedPercent: 25}
+	assert.False(t, filters.Matches(testMissedBlocksEvent{missedBlocks: 2499}, 10000))
+	assert.True(t, filters.Matches(testMissedBlocksEvent{missedBlocks: 2500}, 10000))
+	assert.True(t, filters.Matches(testReportEvent{}, 10000))
+}
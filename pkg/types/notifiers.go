@@ -3,6 +3,7 @@ package types
 import (
 	"main/pkg/constants"
 	"main/pkg/utils"
+	"time"
 )
 
 type Notifier struct {
@@ -10,6 +11,36 @@ type Notifier struct {
 	Reporter        constants.ReporterName
 	UserID          string
 	UserName        string
+	DeliveryMode    constants.DeliveryMode
+	Language        constants.Language
+	MutedUntil      time.Time
+	QuietHours      QuietHours
+	Filters         NotifierFilters
+}
+
+func (n Notifier) IsMentionedInChannel() bool {
+	return n.DeliveryMode != constants.DeliveryModeDM
+}
+
+func (n Notifier) ReceivesDirectMessages() bool {
+	return n.DeliveryMode == constants.DeliveryModeDM || n.DeliveryMode == constants.DeliveryModeBoth
+}
+
+func (n Notifier) IsMuted(now time.Time) bool {
+	return now.Before(n.MutedUntil)
+}
+
+func (n Notifier) IsSilenced(now time.Time) bool {
+	return n.IsMuted(now) || n.QuietHours.IsActive(now)
+}
+
+func (n Notifier) MuteDurationLeft() time.Duration {
+	now := time.Now()
+	if !n.IsMuted(now) {
+		return 0
+	}
+
+	return n.MutedUntil.Sub(now).Round(time.Minute)
 }
 
 func (n Notifier) Equals(another *Notifier) bool {
@@ -29,12 +60,17 @@ func (n Notifiers) AddNotifier(
 	reporter constants.ReporterName,
 	userID string,
 	userName string,
+	filters NotifierFilters,
 ) (*Notifiers, bool) {
 	newNotifier := &Notifier{
 		OperatorAddress: operatorAddress,
 		Reporter:        reporter,
 		UserID:          userID,
 		UserName:        userName,
+		DeliveryMode:    n.GetDeliveryMode(reporter, userID),
+		Language:        n.GetLanguage(reporter, userID),
+		QuietHours:      n.GetQuietHours(reporter, userID),
+		Filters:         filters,
 	}
 
 	if _, found := utils.Find(n, func(notifier *Notifier) bool {
@@ -95,3 +131,173 @@ func (n Notifiers) RemoveNotifier(
 	var newNotifiers Notifiers = newN
 	return &newNotifiers, true
 }
+
+func (n Notifiers) GetDeliveryMode(
+	reporter constants.ReporterName,
+	userID string,
+) constants.DeliveryMode {
+	if notifier, found := utils.Find(n, func(notifier *Notifier) bool {
+		return notifier.UserID == userID && notifier.Reporter == reporter
+	}); found && notifier.DeliveryMode != "" {
+		return notifier.DeliveryMode
+	}
+
+	return constants.DeliveryModeChannel
+}
+
+func (n Notifiers) SetDeliveryMode(
+	reporter constants.ReporterName,
+	userID string,
+	deliveryMode constants.DeliveryMode,
+) (*Notifiers, bool) {
+	return n.update(func(notifier *Notifier) bool {
+		return notifier.UserID == userID && notifier.Reporter == reporter
+	}, func(notifier *Notifier) {
+		notifier.DeliveryMode = deliveryMode
+	})
+}
+
+func (n Notifiers) GetLanguage(
+	reporter constants.ReporterName,
+	userID string,
+) constants.Language {
+	if notifier, found := utils.Find(n, func(notifier *Notifier) bool {
+		return notifier.UserID == userID && notifier.Reporter == reporter
+	}); found {
+		return notifier.Language
+	}
+
+	return ""
+}
+
+func (n Notifiers) SetLanguage(
+	reporter constants.ReporterName,
+	userID string,
+	language constants.Language,
+) (*Notifiers, bool) {
+	return n.update(func(notifier *Notifier) bool {
+		return notifier.UserID == userID && notifier.Reporter == reporter
+	}, func(notifier *Notifier) {
+		notifier.Language = language
+	})
+}
+
+func (n Notifiers) GetQuietHours(
+	reporter constants.ReporterName,
+	userID string,
+) QuietHours {
+	if notifier, found := utils.Find(n, func(notifier *Notifier) bool {
+		return notifier.UserID == userID && notifier.Reporter == reporter
+	}); found {
+		return notifier.QuietHours
+	}
+
+	return QuietHours{}
+}
+
+func (n Notifiers) SetQuietHours(
+	reporter constants.ReporterName,
+	userID string,
+	quietHours QuietHours,
+) (*Notifiers, bool) {
+	return n.update(func(notifier *Notifier) bool {
+		return notifier.UserID == userID && notifier.Reporter == reporter
+	}, func(notifier *Notifier) {
+		notifier.QuietHours = quietHours
+	})
+}
+
+func (n Notifiers) SetMutedUntil(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	mutedUntil time.Time,
+) (*Notifiers, bool) {
+	return n.update(func(notifier *Notifier) bool {
+		return notifier.UserID == userID &&
+			notifier.Reporter == reporter &&
+			(operatorAddress == "" || notifier.OperatorAddress == operatorAddress)
+	}, func(notifier *Notifier) {
+		notifier.MutedUntil = mutedUntil
+	})
+}
+
+func (n Notifiers) SetFilters(
+	operatorAddress string,
+	reporter constants.ReporterName,
+	userID string,
+	filters NotifierFilters,
+) (*Notifiers, bool) {
+	return n.update(func(notifier *Notifier) bool {
+		return notifier.UserID == userID &&
+			notifier.Reporter == reporter &&
+			notifier.OperatorAddress == operatorAddress
+	}, func(notifier *Notifier) {
+		notifier.Filters = filters
+	})
+}
+
+// update returns a new list where the matching notifiers are replaced with changed copies,
+// so the notifiers handed out before are never modified while someone is reading them.
+func (n Notifiers) update(
+	matches func(notifier *Notifier) bool,
+	change func(notifier *Notifier),
+) (*Notifiers, bool) {
+	found := false
+	newNotifiers := make(Notifiers, len(n))
+
+	for index, notifier := range n {
+		if !matches(notifier) {
+			newNotifiers[index] = notifier
+			continue
+		}
+
+		notifierCopy := *notifier
+		change(&notifierCopy)
+		newNotifiers[index] = &notifierCopy
+		found = true
+	}
+
+	if !found {
+		return &n, false
+	}
+
+	return &newNotifiers, true
+}
+
+func (n Notifiers) GetDirectMessages(
+	events []ReportEvent,
+	reporter constants.ReporterName,
+	blocksWindow int64,
+) []*DirectMessage {
+	messages := make([]*DirectMessage, 0)
+	messagesByUser := make(map[string]*DirectMessage)
+	now := time.Now()
+
+	for _, event := range events {
+		notifiers := n.GetNotifiersForReporter(event.GetValidator().OperatorAddress, reporter)
+
+		for _, notifier := range notifiers {
+			if !notifier.ReceivesDirectMessages() ||
+				notifier.IsSilenced(now) ||
+				!notifier.Filters.Matches(event, blocksWindow) {
+				continue
+			}
+
+			message, ok := messagesByUser[notifier.UserID]
+			if !ok {
+				message = &DirectMessage{
+					UserID:   notifier.UserID,
+					UserName: notifier.UserName,
+					Language: notifier.Language,
+				}
+				messagesByUser[notifier.UserID] = message
+				messages = append(messages, message)
+			}
+
+			message.Events = append(message.Events, event)
+		}
+	}
+
+	return messages
+}
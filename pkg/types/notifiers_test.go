@@ -3,6 +3,7 @@ package types
 import (
 	"main/pkg/constants"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -26,3 +27,240 @@ func TestNotifiersEquals(t *testing.T) {
 
 	assert.True(t, first.Equals(second), "Notifiers should be equal")
 }
+
+func TestNotifiersAddNotifierInheritsDeliveryMode(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		&Notifier{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier",
+			UserID:          "id",
+			DeliveryMode:    constants.DeliveryModeDM,
+		},
+	}
+
+	newNotifiers, added := notifiers.AddNotifier("address2", constants.TelegramReporterName, "id", "notifier", NotifierFilters{})
+	assert.True(t, added, "Notifier should be added")
+	assert.Equal(t, constants.DeliveryModeDM, (*newNotifiers)[1].DeliveryMode)
+
+	newNotifiers, added = notifiers.AddNotifier("address2", constants.TelegramReporterName, "id2", "notifier2", NotifierFilters{})
+	assert.True(t, added, "Notifier should be added")
+	assert.Equal(t, constants.DeliveryModeChannel, (*newNotifiers)[1].DeliveryMode)
+}
+
+func TestNotifiersSetDeliveryMode(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		&Notifier{OperatorAddress: "address1", Reporter: constants.TelegramReporterName, UserID: "id"},
+		&Notifier{OperatorAddress: "address2", Reporter: constants.TelegramReporterName, UserID: "id"},
+		&Notifier{OperatorAddress: "address1", Reporter: constants.DiscordReporterName, UserID: "id"},
+	}
+
+	_, found := notifiers.SetDeliveryMode(constants.TelegramReporterName, "id2", constants.DeliveryModeDM)
+	assert.False(t, found)
+
+	newNotifiers, found := notifiers.SetDeliveryMode(constants.TelegramReporterName, "id", constants.DeliveryModeDM)
+	assert.True(t, found)
+	assert.Empty(t, notifiers[0].DeliveryMode, "Original notifiers should not be changed")
+
+	notifiers = *newNotifiers
+	assert.Equal(t, constants.DeliveryModeDM, notifiers[0].DeliveryMode)
+	assert.Equal(t, constants.DeliveryModeDM, notifiers[1].DeliveryMode)
+	assert.Equal(t, constants.DeliveryMode(""), notifiers[2].DeliveryMode)
+	assert.False(t, notifiers[0].IsMentionedInChannel())
+	assert.True(t, notifiers[0].ReceivesDirectMessages())
+	assert.True(t, notifiers[2].IsMentionedInChannel())
+	assert.False(t, notifiers[2].ReceivesDirectMessages())
+}
+
+func TestNotifiersAddNotifierInheritsLanguage(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		&Notifier{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserName:        "notifier",
+			UserID:          "id",
+			Language:        constants.LanguageSpanish,
+		},
+	}
+
+	newNotifiers, added := notifiers.AddNotifier("address2", constants.TelegramReporterName, "id", "notifier", NotifierFilters{})
+	assert.True(t, added, "Notifier should be added")
+	assert.Equal(t, constants.LanguageSpanish, (*newNotifiers)[1].Language)
+
+	newNotifiers, added = notifiers.AddNotifier("address2", constants.TelegramReporterName, "id2", "notifier2", NotifierFilters{})
+	assert.True(t, added, "Notifier should be added")
+	assert.Empty(t, (*newNotifiers)[1].Language)
+}
+
+func TestNotifiersSetLanguage(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		&Notifier{OperatorAddress: "address1", Reporter: constants.TelegramReporterName, UserID: "id"},
+		&Notifier{OperatorAddress: "address2", Reporter: constants.TelegramReporterName, UserID: "id"},
+		&Notifier{OperatorAddress: "address1", Reporter: constants.DiscordReporterName, UserID: "id"},
+	}
+
+	_, found := notifiers.SetLanguage(constants.TelegramReporterName, "id2", constants.LanguageChinese)
+	assert.False(t, found)
+
+	newNotifiers, found := notifiers.SetLanguage(constants.TelegramReporterName, "id", constants.LanguageChinese)
+	assert.True(t, found)
+	assert.Empty(t, notifiers.GetLanguage(constants.TelegramReporterName, "id"))
+
+	notifiers = *newNotifiers
+	assert.Equal(t, constants.LanguageChinese, notifiers.GetLanguage(constants.TelegramReporterName, "id"))
+	assert.Equal(t, constants.LanguageChinese, notifiers[1].Language)
+	assert.Empty(t, notifiers.GetLanguage(constants.DiscordReporterName, "id"))
+}
+
+func TestNotifiersSetMutedUntil(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		{OperatorAddress: "address1", Reporter: constants.TelegramReporterName, UserID: "id"},
+		{OperatorAddress: "address2", Reporter: constants.TelegramReporterName, UserID: "id"},
+		{OperatorAddress: "address1", Reporter: constants.TelegramReporterName, UserID: "id2"},
+	}
+
+	now := time.Now()
+	mutedUntil := now.Add(time.Hour)
+
+	_, found := notifiers.SetMutedUntil("address3", constants.TelegramReporterName, "id", mutedUntil)
+	assert.False(t, found)
+
+	newNotifiers, found := notifiers.SetMutedUntil("address1", constants.TelegramReporterName, "id", mutedUntil)
+	assert.True(t, found)
+	assert.False(t, notifiers[0].IsMuted(now))
+
+	notifiers = *newNotifiers
+	assert.True(t, notifiers[0].IsMuted(now))
+	assert.True(t, notifiers[0].IsSilenced(now))
+	assert.False(t, notifiers[0].IsMuted(mutedUntil))
+	assert.Equal(t, time.Hour, notifiers[0].MuteDurationLeft())
+	assert.False(t, notifiers[1].IsMuted(now))
+	assert.Zero(t, notifiers[1].MuteDurationLeft())
+	assert.False(t, notifiers[2].IsMuted(now))
+
+	newNotifiers, found = notifiers.SetMutedUntil("", constants.TelegramReporterName, "id", time.Time{})
+	assert.True(t, found)
+
+	notifiers = *newNotifiers
+	assert.False(t, notifiers[0].IsMuted(now))
+	assert.False(t, notifiers[1].IsMuted(now))
+}
+
+func TestNotifiersQuietHours(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		{OperatorAddress: "address1", Reporter: constants.TelegramReporterName, UserID: "id"},
+		{OperatorAddress: "address2", Reporter: constants.TelegramReporterName, UserID: "id"},
+	}
+
+	quietHours := QuietHours{Start: "00:00", End: "23:59", Timezone: "UTC"}
+
+	_, found := notifiers.SetQuietHours(constants.TelegramReporterName, "id2", quietHours)
+	assert.False(t, found)
+
+	newNotifiers, found := notifiers.SetQuietHours(constants.TelegramReporterName, "id", quietHours)
+	assert.True(t, found)
+
+	notifiers = *newNotifiers
+	assert.Equal(t, quietHours, notifiers.GetQuietHours(constants.TelegramReporterName, "id"))
+	assert.Equal(t, QuietHours{}, notifiers.GetQuietHours(constants.TelegramReporterName, "id2"))
+
+	newNotifiers, added := notifiers.AddNotifier("address3", constants.TelegramReporterName, "id", "name", NotifierFilters{})
+	assert.True(t, added)
+	assert.Equal(t, quietHours, (*newNotifiers)[2].QuietHours)
+}
+
+func TestNotifiersGetDirectMessagesSkipsMuted(t *testing.T) {
+	t.Parallel()
+
+	validator := &Validator{OperatorAddress: "address"}
+	notifiers := Notifiers{
+		{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserID:          "id",
+			DeliveryMode:    constants.DeliveryModeDM,
+			MutedUntil:      time.Now().Add(time.Hour),
+		},
+		{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserID:          "id2",
+			DeliveryMode:    constants.DeliveryModeDM,
+			Language:        constants.LanguageRussian,
+		},
+	}
+
+	messages := notifiers.GetDirectMessages([]ReportEvent{testReportEvent{validator: validator}}, constants.TelegramReporterName, 10000)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "id2", messages[0].UserID)
+	assert.Equal(t, constants.LanguageRussian, messages[0].Language)
+}
+
+func TestNotifiersGetDirectMessagesSkipsFiltered(t *testing.T) {
+	t.Parallel()
+
+	validator := &Validator{OperatorAddress: "address"}
+	notifiers := Notifiers{
+		{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserID:          "id",
+			DeliveryMode:    constants.DeliveryModeDM,
+			Filters:         NotifierFilters{Events: []constants.EventName{constants.EventValidatorTombstoned}},
+		},
+		{
+			OperatorAddress: "address",
+			Reporter:        constants.TelegramReporterName,
+			UserID:          "id2",
+			DeliveryMode:    constants.DeliveryModeDM,
+			Filters:         NotifierFilters{Events: []constants.EventName{constants.EventValidatorJailed}},
+		},
+	}
+
+	messages := notifiers.GetDirectMessages([]ReportEvent{testReportEvent{validator: validator}}, constants.TelegramReporterName, 10000)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "id2", messages[0].UserID)
+}
+
+func TestNotifiersSetFilters(t *testing.T) {
+	t.Parallel()
+
+	notifiers := Notifiers{
+		{OperatorAddress: "address", Reporter: constants.TelegramReporterName, UserID: "id"},
+		{OperatorAddress: "address2", Reporter: constants.TelegramReporterName, UserID: "id"},
+	}
+
+	filters := NotifierFilters{MinMissedPercent: 10}
+	newNotifiers, found := notifiers.SetFilters("address", constants.TelegramReporterName, "id", filters)
+	assert.True(t, found)
+	assert.True(t, notifiers[0].Filters.IsEmpty(), "Original notifiers should not be changed")
+	assert.Equal(t, filters, (*newNotifiers)[0].Filters)
+	assert.Same(t, notifiers[1], (*newNotifiers)[1])
+
+	_, found = notifiers.SetFilters("address3", constants.TelegramReporterName, "id", filters)
+	assert.False(t, found)
+}
+
+type testReportEvent struct {
+	validator *Validator
+}
+
+func (e testReportEvent) Type() constants.EventName {
+	return constants.EventValidatorJailed
+}
+
+func (e testReportEvent) GetValidator() *Validator {
+	return e.validator
+}
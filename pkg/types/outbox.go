@@ -0,0 +1,19 @@
+package types
+
+import (
+	"main/pkg/constants"
+	"time"
+)
+
+type OutboxEntry struct {
+	ID            int64
+	Reporter      constants.ReporterName
+	Report        *Report
+	Attempts      int
+	CreatedAt     time.Time
+	NextAttemptAt time.Time
+}
+
+func (e *OutboxEntry) IsDue(now time.Time) bool {
+	return !e.NextAttemptAt.After(now)
+}
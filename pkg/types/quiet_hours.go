@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const quietHoursLayout = "15:04"
+
+type QuietHours struct {
+	Start    string
+	End      string
+	Timezone string
+}
+
+func ParseQuietHours(start, end, timezone string) (QuietHours, error) {
+	if _, err := time.Parse(quietHoursLayout, start); err != nil {
+		return QuietHours{}, fmt.Errorf("invalid start time %s, expected HH:MM", start)
+	}
+
+	if _, err := time.Parse(quietHoursLayout, end); err != nil {
+		return QuietHours{}, fmt.Errorf("invalid end time %s, expected HH:MM", end)
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return QuietHours{}, fmt.Errorf("invalid timezone %s", timezone)
+	}
+
+	return QuietHours{Start: start, End: end, Timezone: timezone}, nil
+}
+
+func (q QuietHours) Enabled() bool {
+	return q.Start != "" && q.End != ""
+}
+
+func (q QuietHours) IsActive(now time.Time) bool {
+	if !q.Enabled() {
+		return false
+	}
+
+	location, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return false
+	}
+
+	start, err := time.Parse(quietHoursLayout, q.Start)
+	if err != nil {
+		return false
+	}
+
+	end, err := time.Parse(quietHoursLayout, q.End)
+	if err != nil {
+		return false
+	}
+
+	localNow := now.In(location)
+	current := localNow.Hour()*60 + localNow.Minute()
+	startMinutes := start.Hour()*60 + start.Minute()
+	endMinutes := end.Hour()*60 + end.Minute()
+
+	// quiet hours spanning midnight, like 22:00 - 07:00
+	if startMinutes > endMinutes {
+		return current >= startMinutes || current < endMinutes
+	}
+
+	return current >= startMinutes && current < endMinutes
+}
+
+func (q QuietHours) String() string {
+	if !q.Enabled() {
+		return "disabled"
+	}
+
+	return fmt.Sprintf("%s - %s (%s)", q.Start, q.End, q.Timezone)
+}
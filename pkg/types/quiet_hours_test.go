@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuietHoursInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseQuietHours("25:00", "07:00", "UTC")
+	require.Error(t, err)
+
+	_, err = ParseQuietHours("22:00", "invalid", "UTC")
+	require.Error(t, err)
+
+	_, err = ParseQuietHours("22:00", "07:00", "Mars/Olympus")
+	require.Error(t, err)
+}
+
+func TestParseQuietHoursValid(t *testing.T) {
+	t.Parallel()
+
+	quietHours, err := ParseQuietHours("22:00", "07:00", "Europe/Berlin")
+	require.NoError(t, err)
+	assert.True(t, quietHours.Enabled())
+	assert.Equal(t, "22:00 - 07:00 (Europe/Berlin)", quietHours.String())
+}
+
+func TestQuietHoursDisabled(t *testing.T) {
+	t.Parallel()
+
+	quietHours := QuietHours{}
+	assert.False(t, quietHours.Enabled())
+	assert.False(t, quietHours.IsActive(time.Now()))
+	assert.Equal(t, "disabled", quietHours.String())
+}
+
+func TestQuietHoursIsActiveSameDay(t *testing.T) {
+	t.Parallel()
+
+	quietHours := QuietHours{Start: "09:00", End: "17:00", Timezone: "UTC"}
+
+	assert.False(t, quietHours.IsActive(time.Date(2024, 1, 1, 8, 59, 0, 0, time.UTC)))
+	assert.True(t, quietHours.IsActive(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, quietHours.IsActive(time.Date(2024, 1, 1, 16, 59, 0, 0, time.UTC)))
+	assert.False(t, quietHours.IsActive(time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC)))
+}
+
+func TestQuietHoursIsActiveOvernight(t *testing.T) {
+	t.Parallel()
+
+	quietHours := QuietHours{Start: "22:00", End: "07:00", Timezone: "Europe/Berlin"}
+
+	// 21:30 UTC is 22:30 in Berlin in winter
+	assert.True(t, quietHours.IsActive(time.Date(2024, 1, 1, 21, 30, 0, 0, time.UTC)))
+	assert.True(t, quietHours.IsActive(time.Date(2024, 1, 1, 5, 0, 0, 0, time.UTC)))
+	assert.False(t, quietHours.IsActive(time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)))
+	assert.False(t, quietHours.IsActive(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+}
@@ -7,4 +7,13 @@ type RenderEventItem struct {
 	ValidatorLink Link
 	Event         ReportEvent
 	TimeToJail    time.Duration
+	Incident      *Incident
+}
+
+func (i RenderEventItem) GetAckedBy() string {
+	if i.Incident == nil {
+		return ""
+	}
+
+	return i.Incident.AckedBy
 }
@@ -3,24 +3,78 @@ package types
 import (
 	htmlTemplate "html/template"
 	"main/pkg/constants"
+	"time"
 )
 
+// ReportEventRenderData is what the event templates are rendered with, having
+// the event itself and the parts of it that are serialized by the reporter.
 type ReportEventRenderData struct {
-	Notifiers     string
+	Event         ReportEvent
+	Notifiers     htmlTemplate.HTML
 	ValidatorLink htmlTemplate.HTML
-	TimeToJail    string
+	TimeToJail    time.Duration
 }
 
 type ReportEvent interface {
 	Type() constants.EventName
 	GetValidator() *Validator
-	Render(formatType constants.FormatType, renderData ReportEventRenderData) string
 }
 
 type Report struct {
+	Height int64
 	Events []ReportEvent
+
+	// Deliveries has what was rendered for each destination of the report, keyed by the destination,
+	// so a retry sends the same content and skips the destinations the report was already delivered to.
+	Deliveries map[string]*ReportDelivery
+}
+
+type ReportDelivery struct {
+	Content string `json:"content"`
+	Sent    bool   `json:"sent"`
 }
 
 func (d *Report) Empty() bool {
 	return len(d.Events) == 0
 }
+
+// Deliver sends the content rendered for the destination, rendering it only on the first attempt,
+// and does nothing if the destination has already got it.
+func (d *Report) Deliver(
+	destination string,
+	render func() (string, error),
+	send func(content string) error,
+) error {
+	if d.Deliveries == nil {
+		d.Deliveries = make(map[string]*ReportDelivery)
+	}
+
+	delivery, found := d.Deliveries[destination]
+	if found && delivery.Sent {
+		return nil
+	}
+
+	if !found {
+		content, err := render()
+		if err != nil {
+			return err
+		}
+
+		delivery = &ReportDelivery{Content: content}
+		d.Deliveries[destination] = delivery
+	}
+
+	if err := send(delivery.Content); err != nil {
+		return err
+	}
+
+	delivery.Sent = true
+	return nil
+}
+
+type DirectMessage struct {
+	UserID   string
+	UserName string
+	Language constants.Language
+	Events   []ReportEvent
+}
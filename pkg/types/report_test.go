@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportDeliverRendersOnceAndSkipsDelivered(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{}
+	renders := 0
+	sent := make([]string, 0)
+
+	render := func(content string) func() (string, error) {
+		return func() (string, error) {
+			renders++
+			return content, nil
+		}
+	}
+
+	failing := func(string) error { return errors.New("error") }
+	sending := func(content string) error {
+		sent = append(sent, content)
+		return nil
+	}
+
+	require.NoError(t, report.Deliver("first", render("first content"), sending))
+	require.Error(t, report.Deliver("second", render("second content"), failing))
+
+	// the retry sends the content rendered on the first attempt, and skips the delivered destination
+	require.NoError(t, report.Deliver("first", render("changed"), sending))
+	require.NoError(t, report.Deliver("second", render("changed"), sending))
+
+	assert.Equal(t, 2, renders)
+	assert.Equal(t, []string{"first content", "second content"}, sent)
+	assert.True(t, report.Deliveries["second"].Sent)
+}
+
+func TestReportDeliverRenderError(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{}
+	err := report.Deliver(
+		"destination",
+		func() (string, error) { return "", errors.New("error") },
+		func(string) error { return nil },
+	)
+
+	require.Error(t, err)
+	assert.Empty(t, report.Deliveries)
+}
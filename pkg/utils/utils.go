@@ -3,8 +3,11 @@ package utils
 import (
 	"encoding/hex"
 	"fmt"
+	"html"
 	"math"
 	"math/rand"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -178,6 +181,34 @@ func MustConvertBech32Prefix(address, newPrefix string) string {
 	return encoded
 }
 
+// ParseDuration is time.ParseDuration that also accepts days, like "1d" or "2d12h".
+func ParseDuration(value string) (time.Duration, error) {
+	var days int64
+
+	if index := strings.Index(value, "d"); index != -1 {
+		parsed, err := strconv.ParseInt(value[:index], 10, 64)
+		if err != nil || parsed < 0 {
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+
+		days = parsed
+
+		value = value[index+1:]
+	}
+
+	duration := time.Duration(days) * 24 * time.Hour
+	if value == "" {
+		return duration, nil
+	}
+
+	rest, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return duration + rest, nil
+}
+
 func FormatDuration(duration time.Duration) string {
 	days := int64(duration.Hours() / 24)
 	hours := int64(math.Mod(duration.Hours(), 24))
@@ -225,3 +256,15 @@ func MinInt64(a, b int64) int64 {
 
 	return a
 }
+
+var htmlTagsRegexp = regexp.MustCompile(`<[^>]*>`)
+
+func StripHTML(text string) string {
+	return html.UnescapeString(htmlTagsRegexp.ReplaceAllString(text, ""))
+}
+
+func EscapeSlack(text string) string {
+	// Slack requires only these three symbols to be escaped, see
+	// https://api.slack.com/reference/surfaces/formatting#escaping
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
@@ -78,6 +78,31 @@ func TestContainsNotFound(t *testing.T) {
 	assert.False(t, found, "Value should not be found!")
 }
 
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	duration, err := ParseDuration("2h30m")
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour+30*time.Minute, duration)
+
+	duration, err = ParseDuration("1d")
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, duration)
+
+	duration, err = ParseDuration("2d12h")
+	require.NoError(t, err)
+	assert.Equal(t, 60*time.Hour, duration)
+
+	_, err = ParseDuration("xd")
+	require.Error(t, err)
+
+	_, err = ParseDuration("1d2x")
+	require.Error(t, err)
+
+	_, err = ParseDuration("invalid")
+	require.Error(t, err)
+}
+
 func TestFormatDuration(t *testing.T) {
 	t.Parallel()
 
@@ -277,3 +302,19 @@ func TestMustDecodeBech32Ok(t *testing.T) {
 	value := MustDecodeBech32("cosmosvaloper1xqz9pemz5e5zycaa89kys5aw6m8rhgsvw4328e")
 	require.Equal(t, "0600020501191b021419140204181d1d0705160410141d0e1a1b07031708100c", value)
 }
+
+func TestStripHTML(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(
+		t,
+		"❌ Quokka & Stake has been jailed",
+		StripHTML("<strong>❌ <a href='https://example.com'>Quokka &amp; Stake</a> has been jailed</strong>"),
+	)
+}
+
+func TestEscapeSlack(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "is skipping blocks (&gt; 1.0%) &amp; &lt;test&gt;", EscapeSlack("is skipping blocks (> 1.0%) & <test>"))
+}